@@ -0,0 +1,73 @@
+package celo
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/registry"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/sortedoracles"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+)
+
+const (
+	DefaultBudgetWindow         = 24 * time.Hour
+	DefaultBudgetAlertThreshold = 0.8
+)
+
+// NewBudgetTracker creates the tracker limiting the relayer account spending
+// as configured in the chain budget section.
+func NewBudgetTracker(config *CeloConfig, client calls.ContractCallerDispatcher) *transaction.BudgetTracker {
+	amount, _ := new(big.Int).SetString(config.Budget.Amount, 10)
+	window := DefaultBudgetWindow
+	if config.Budget.Window != 0 {
+		window = time.Duration(config.Budget.Window) * time.Second
+	}
+	alertThreshold := DefaultBudgetAlertThreshold
+	if config.Budget.AlertThreshold != 0 {
+		alertThreshold = config.Budget.AlertThreshold
+	}
+
+	alerts := metric.Must(global.Meter("chainbridge-celo")).NewInt64Counter(
+		"chainbridge.celo.BudgetAlertCount",
+		metric.WithDescription("Number of times a relayer account approached its spending budget"),
+	)
+	domainID := attribute.Int("domainID", int(*config.GeneralChainConfig.Id))
+	return transaction.NewBudgetTracker(
+		amount,
+		window,
+		alertThreshold,
+		&sortedOraclesConverter{client: client},
+		func(account common.Address, spent *big.Int, budget *big.Int) {
+			alerts.Add(context.Background(), 1, domainID, attribute.String("account", account.Hex()))
+		},
+	)
+}
+
+// sortedOraclesConverter prices fee currencies at the SortedOracles median
+// rate. The SortedOracles address is resolved through the Registry on first use.
+type sortedOraclesConverter struct {
+	client        calls.ContractCallerDispatcher
+	lock          sync.Mutex
+	sortedOracles *sortedoracles.SortedOraclesContract
+}
+
+func (c *sortedOraclesConverter) ToCelo(feeCurrency common.Address, amount *big.Int) (*big.Int, error) {
+	c.lock.Lock()
+	if c.sortedOracles == nil {
+		address, err := registry.NewRegistryContract(c.client, registry.RegistryAddress).GetAddressFor(registry.SortedOraclesID)
+		if err != nil {
+			c.lock.Unlock()
+			return nil, err
+		}
+		c.sortedOracles = sortedoracles.NewSortedOraclesContract(c.client, address)
+	}
+	c.lock.Unlock()
+	return c.sortedOracles.ToCelo(feeCurrency, amount)
+}
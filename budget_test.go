@@ -0,0 +1,83 @@
+package celo
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/consts"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/registry"
+	"github.com/ChainSafe/chainbridge-celo-module/internal/calltest"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/suite"
+)
+
+type BudgetTrackerTestSuite struct {
+	suite.Suite
+	caller        *calltest.ContractCaller
+	sortedOracles common.Address
+	cUSD          common.Address
+}
+
+func TestRunBudgetTrackerTestSuite(t *testing.T) {
+	suite.Run(t, new(BudgetTrackerTestSuite))
+}
+
+func (s *BudgetTrackerTestSuite) SetupTest() {
+	s.sortedOracles = common.HexToAddress("0xefB84935239dAcdecF7c5bA76d8dE40b077B7b33")
+	s.cUSD = common.HexToAddress("0x874069Fa1Eb16D44d622F2e0Ca25eeA172369bC1")
+	s.caller = calltest.NewContractCaller()
+	s.caller.Respond(registry.RegistryAddress, consts.RegistryABI, "getAddressForStringOrDie", s.sortedOracles)
+	// 2 cUSD per CELO
+	s.caller.Respond(s.sortedOracles, consts.SortedOraclesABI, "medianRate", big.NewInt(2), big.NewInt(1))
+}
+
+func (s *BudgetTrackerTestSuite) TestConvertsFeeCurrencyCost() {
+	id := uint8(1)
+	config := &CeloConfig{
+		EVMConfig: &chain.EVMConfig{GeneralChainConfig: chain.GeneralChainConfig{Id: &id}},
+		Budget:    BudgetConfig{Amount: "1000", Window: 3600},
+	}
+	tracker := NewBudgetTracker(config, s.caller)
+
+	to := common.HexToAddress("0x1")
+	tx, _ := transaction.NewCeloTransaction(0, &to, big.NewInt(10), 100, []*big.Int{big.NewInt(4)}, nil)
+	cost, err := tracker.CeloCost(tx.(*transaction.CeloTransaction))
+	s.Nil(err)
+	s.Equal(big.NewInt(410), cost)
+
+	feeTx := s.feeCurrencyTx(to, big.NewInt(10), 100, big.NewInt(4))
+	cost, err = tracker.CeloCost(feeTx)
+	s.Nil(err)
+	// 400 cUSD of fees are 200 CELO
+	s.Equal(big.NewInt(210), cost)
+
+	_, err = tracker.CeloCost(feeTx)
+	s.Nil(err)
+	// the SortedOracles address is resolved only once
+	s.Equal(3, s.caller.Calls())
+}
+
+// feeCurrencyTx returns an unsigned transaction paying its fees in cUSD
+func (s *BudgetTrackerTestSuite) feeCurrencyTx(to common.Address, value *big.Int, gas uint64, gasPrice *big.Int) *transaction.CeloTransaction {
+	raw, err := json.Marshal(map[string]interface{}{
+		"nonce":       hexutil.Uint64(0),
+		"gasPrice":    (*hexutil.Big)(gasPrice),
+		"gas":         hexutil.Uint64(gas),
+		"feeCurrency": s.cUSD,
+		"gatewayFee":  (*hexutil.Big)(big.NewInt(0)),
+		"to":          to,
+		"value":       (*hexutil.Big)(value),
+		"input":       hexutil.Bytes{},
+		"v":           (*hexutil.Big)(big.NewInt(0)),
+		"r":           (*hexutil.Big)(big.NewInt(0)),
+		"s":           (*hexutil.Big)(big.NewInt(0)),
+	})
+	s.Require().Nil(err)
+	tx := new(transaction.CeloTransaction)
+	s.Require().Nil(json.Unmarshal(raw, tx))
+	return tx
+}
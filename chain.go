@@ -1,52 +1,201 @@
 package celo
 
 import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	celoClient "github.com/ChainSafe/chainbridge-celo-module/client"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
 	"github.com/ChainSafe/chainbridge-core/chains/evm"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/voter"
-	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
 	"github.com/ChainSafe/chainbridge-core/store"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog/log"
 )
 
+// CeloChain is an EVMChain that runs background tasks, such as re-checking the
+// relayer role, for as long as it polls events and releases its resources once
+// it stops polling
+type CeloChain struct {
+	*evm.EVMChain
+	background []func(stop <-chan struct{})
+	closers    []io.Closer
+}
+
+func newCeloChain(chain *evm.EVMChain, background ...func(stop <-chan struct{})) *CeloChain {
+	return &CeloChain{EVMChain: chain, background: background}
+}
+
+// PollEvents starts the background tasks, which stop together with the chain
+// when stop is closed, and polls events until then. The closers are closed
+// once polling stops.
+func (c *CeloChain) PollEvents(stop <-chan struct{}, sysErr chan<- error, eventsChan chan *message.Message) {
+	for _, task := range c.background {
+		go task(stop)
+	}
+	defer c.close()
+	c.EVMChain.PollEvents(stop, sysErr, eventsChan)
+}
+
+func (c *CeloChain) close() {
+	for _, closer := range c.closers {
+		if err := closer.Close(); err != nil {
+			log.Error().Uint8("domainID", c.DomainID()).Msgf("Failed closing chain resource: %s", err)
+		}
+	}
+}
+
+// SetupDefaultCeloChain sets up a celo chain as an EVMChain. The background
+// tasks and closers of the chain are left out, so the relayer role is only
+// checked at startup and the observer file is not closed when polling stops.
+// Use SetupCeloChain to run them.
 func SetupDefaultCeloChain(rawConfig map[string]interface{}, txFabric calls.TxFabric, blockstore *store.BlockStore) (*evm.EVMChain, error) {
-	config, err := chain.NewEVMConfig(rawConfig)
+	chain, err := SetupCeloChain(rawConfig, txFabric, blockstore)
+	if err != nil {
+		return nil, err
+	}
+	return chain.EVMChain, nil
+}
+
+// SetupCeloChain sets up a celo chain, which re-checks the relayer role and
+// closes the observer file together with polling events
+func SetupCeloChain(rawConfig map[string]interface{}, txFabric calls.TxFabric, blockstore *store.BlockStore) (*CeloChain, error) {
+	config, err := NewCeloConfig(rawConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := evmclient.NewEVMClient(config)
+	if config.Observer {
+		return setupObserverChain(config, blockstore)
+	}
+
+	setupReplayProtection(config)
+
+	client, err := celoClient.NewChainClient(config.EVMConfig, config.Signer)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkChainID(config, client); err != nil {
+		return nil, err
+	}
+	if config.GoldTokenResourceID != "" {
+		if err := CheckGoldTokenResource(config, client); err != nil {
+			return nil, err
+		}
+	}
+	if config.Budget.Amount != "" {
+		client.WrapSender(NewBudgetTracker(config, client).SendFn)
+	}
+	if !config.Policy.Disabled {
+		policy, err := NewSigningPolicy(config)
+		if err != nil {
+			return nil, err
+		}
+		client.WrapSigner(func(signerFn transaction.SignerFn) transaction.SignerFn {
+			return transaction.NewPolicySignerFn(policy, signerFn)
+		})
+	}
 
+	abis, err := transaction.BridgeABIs()
+	if err != nil {
+		return nil, err
+	}
+	// receipts are waited for through a WaitingClient so that reverted votes are
+	// replayed and their revert reason is logged
+	dispatcher := celoClient.NewWaitingClient(client, client.EVMClient, ReceiptTimeout, logTxStatus, abis...)
 	gasPricer := evmgaspricer.NewStaticGasPriceDeterminant(client, nil)
-	t := signAndSend.NewSignAndSendTransactor(txFabric, gasPricer, client)
+	t := signAndSend.NewSignAndSendTransactor(txFabric, gasPricer, dispatcher)
 	bridgeContract := bridge.NewBridgeContract(client, common.HexToAddress(config.Bridge), t)
+	voterBridge := NewRevertDecodingBridge(bridgeContract, abis)
+
+	evmListener := newListener(config, client, bridgeContract)
+	mh := newMessageHandler(config, bridgeContract)
 
+	var evmVoter *voter.EVMVoter
+	evmVoter, err = voter.NewVoterWithSubscription(mh, client, voterBridge)
+	if err != nil {
+		log.Error().Msgf("failed creating voter with subscription: %s. Falling back to default voter.", err.Error())
+		evmVoter = voter.NewVoter(mh, client, voterBridge)
+	}
+
+	guard := NewRelayerRoleGuard(evmVoter, bridgeContract, client.RelayerAddress(), *config.GeneralChainConfig.Id)
+	isRelayer, err := guard.Check()
+	if err != nil {
+		return nil, fmt.Errorf("failed checking relayer role: %w", err)
+	}
+	if !isRelayer && config.NotRelayer != NotRelayerListen {
+		return nil, fmt.Errorf("%s is not a relayer of bridge %s, set notRelayer to %s to start listen-only", client.RelayerAddress().Hex(), config.Bridge, NotRelayerListen)
+	}
+	checkInterval := DefaultRelayerCheckInterval
+	if config.RelayerCheckInterval != 0 {
+		checkInterval = time.Duration(config.RelayerCheckInterval) * time.Second
+	}
+	watch := func(stop <-chan struct{}) {
+		guard.Watch(checkInterval, stop)
+	}
+
+	return newCeloChain(evm.NewEVMChain(evmListener, guard, blockstore, config.EVMConfig), watch), nil
+}
+
+// setupObserverChain sets up a chain that listens to deposits like a relayer
+// chain but records the messages it would vote on instead of voting. No key is
+// loaded and no transaction is sent.
+func setupObserverChain(config *CeloConfig, blockstore *store.BlockStore) (*CeloChain, error) {
+	client, err := celoClient.NewCeloClientFromParams(config.GeneralChainConfig.Endpoint, &transaction.TransactOpts{From: common.HexToAddress(config.GeneralChainConfig.From)})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkChainID(config, client); err != nil {
+		return nil, err
+	}
+	path := config.ObserverPath
+	if path == "" {
+		path = fmt.Sprintf(DefaultObserverPath, *config.GeneralChainConfig.Id)
+	}
+	out, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening observer file: %w", err)
+	}
+
+	bridgeContract := bridge.NewBridgeContract(client, common.HexToAddress(config.Bridge), nil)
+	evmListener := newListener(config, client, bridgeContract)
+	recorder := NewMessageRecorder(newMessageHandler(config, bridgeContract), bridgeContract, out)
+	log.Warn().Uint8("domainID", *config.GeneralChainConfig.Id).Msgf("Chain is an observer, messages are recorded to %s instead of voted on", path)
+
+	chain := newCeloChain(evm.NewEVMChain(evmListener, recorder, blockstore, config.EVMConfig))
+	chain.closers = append(chain.closers, recorder)
+	return chain, nil
+}
+
+// checkChainID checks the chain ID served by the endpoint at startup if
+// checkChainId is enabled
+func checkChainID(config *CeloConfig, client ChainIDReader) error {
+	if !config.CheckChainID {
+		return nil
+	}
+	return CheckChainID(config, client)
+}
+
+func newListener(config *CeloConfig, client listener.ChainClient, bridgeContract *bridge.BridgeContract) *listener.EVMListener {
 	eventHandler := listener.NewETHEventHandler(*bridgeContract)
 	eventHandler.RegisterEventHandler(config.Erc20Handler, listener.Erc20EventHandler)
 	eventHandler.RegisterEventHandler(config.Erc721Handler, listener.Erc721EventHandler)
 	eventHandler.RegisterEventHandler(config.GenericHandler, listener.GenericEventHandler)
-	evmListener := listener.NewEVMListener(client, eventHandler, common.HexToAddress(config.Bridge))
+	return listener.NewEVMListener(client, eventHandler, common.HexToAddress(config.Bridge))
+}
 
+func newMessageHandler(config *CeloConfig, bridgeContract *bridge.BridgeContract) *voter.EVMMessageHandler {
 	mh := voter.NewEVMMessageHandler(*bridgeContract)
 	mh.RegisterMessageHandler(config.Erc20Handler, voter.ERC20MessageHandler)
 	mh.RegisterMessageHandler(config.Erc721Handler, voter.ERC721MessageHandler)
 	mh.RegisterMessageHandler(config.GenericHandler, voter.GenericMessageHandler)
-
-	var evmVoter *voter.EVMVoter
-	evmVoter, err = voter.NewVoterWithSubscription(mh, client, bridgeContract)
-	if err != nil {
-		log.Error().Msgf("failed creating voter with subscription: %s. Falling back to default voter.", err.Error())
-		evmVoter = voter.NewVoter(mh, client, bridgeContract)
-	}
-
-	return evm.NewEVMChain(evmListener, evmVoter, blockstore, config), nil
+	return mh
 }
@@ -0,0 +1,26 @@
+package celo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+// ChainIDReader returns the chain ID of the network a node serves
+type ChainIDReader interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// CheckChainID verifies the configured endpoint serves the network with the
+// configured chain ID, so that a relayer pointed at the wrong network fails
+// before it signs anything.
+func CheckChainID(config *CeloConfig, client ChainIDReader) error {
+	id, err := client.ChainID(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed getting chain ID: %w", err)
+	}
+	if id.Cmp(new(big.Int).SetUint64(config.ChainID)) != 0 {
+		return fmt.Errorf("endpoint %s serves chain ID %s instead of the configured %d", config.GeneralChainConfig.Endpoint, id, config.ChainID)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package celo
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeChainIDReader struct {
+	id  *big.Int
+	err error
+}
+
+func (r *fakeChainIDReader) ChainID(ctx context.Context) (*big.Int, error) {
+	return r.id, r.err
+}
+
+type ChainIDTestSuite struct {
+	suite.Suite
+	config *CeloConfig
+}
+
+func TestRunChainIDTestSuite(t *testing.T) {
+	suite.Run(t, new(ChainIDTestSuite))
+}
+
+func (s *ChainIDTestSuite) SetupTest() {
+	s.config = &CeloConfig{
+		EVMConfig: &chain.EVMConfig{GeneralChainConfig: chain.GeneralChainConfig{Endpoint: "http://localhost:8545"}},
+		ChainID:   44787,
+	}
+}
+
+func (s *ChainIDTestSuite) TestPassesConfiguredChainID() {
+	s.Nil(CheckChainID(s.config, &fakeChainIDReader{id: big.NewInt(44787)}))
+}
+
+func (s *ChainIDTestSuite) TestFailsOnMismatch() {
+	err := CheckChainID(s.config, &fakeChainIDReader{id: big.NewInt(42220)})
+	s.EqualError(err, "endpoint http://localhost:8545 serves chain ID 42220 instead of the configured 44787")
+}
+
+func (s *ChainIDTestSuite) TestFailsOnUnreachableEndpoint() {
+	err := CheckChainID(s.config, &fakeChainIDReader{err: errors.New("connection refused")})
+	s.NotNil(err)
+}
+
+func (s *ChainIDTestSuite) TestSkippedAtStartupUnlessEnabled() {
+	reader := &fakeChainIDReader{id: big.NewInt(42220)}
+	s.Nil(checkChainID(s.config, reader))
+
+	s.config.CheckChainID = true
+	s.NotNil(checkChainID(s.config, reader))
+}
+
+func (s *ChainIDTestSuite) TestEnablingRequiresChainID() {
+	s.EqualError((&RawCeloConfig{CheckChainID: true}).Validate(), "checkChainId requires chainId")
+	s.Nil((&RawCeloConfig{CheckChainID: true, ChainID: 42220}).Validate())
+}
@@ -0,0 +1,27 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/spf13/cobra"
+)
+
+var AccountCeloCmd = &cobra.Command{
+	Use:   "account",
+	Short: "Set of commands for inspecting Celo accounts",
+	Long:  "Set of commands for inspecting Celo accounts",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		// fetch global flag values
+		url, _, _, senderKeyPair, _, err = flags.GlobalFlagValues(cmd)
+		if err != nil {
+			return fmt.Errorf("could not get global flags: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	AccountCeloCmd.AddCommand(balanceCmd)
+}
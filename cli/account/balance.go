@@ -0,0 +1,195 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/feecurrencywhitelist"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/registry"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/stabletoken"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+// celoDecimals is the number of decimals of native CELO
+const celoDecimals = 18
+
+var balanceCmd = &cobra.Command{
+	Use:   "balance <address>",
+	Short: "Get CELO and stable token balances of an account",
+	Long:  "The balance subcommand prints the native CELO balance and the balances of all tokens in the fee currency whitelist, together with the current and pending nonce of the account",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return BalanceCmd(cmd, args, c)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateBalanceFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessBalanceFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindBalanceFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&JSON, "json", false, "Print the balances as JSON")
+}
+
+func init() {
+	BindBalanceFlags(balanceCmd)
+}
+
+func ValidateBalanceFlags(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected the account address as the only argument")
+	}
+	if !common.IsHexAddress(args[0]) {
+		return fmt.Errorf("invalid account address %s", args[0])
+	}
+	return nil
+}
+
+func ProcessBalanceFlags(cmd *cobra.Command, args []string) {
+	AccountAddr = common.HexToAddress(args[0])
+}
+
+// TokenBalance is the balance of an account in a single token
+type TokenBalance struct {
+	Symbol   string          `json:"symbol"`
+	Address  *common.Address `json:"address,omitempty"`
+	Decimals uint8           `json:"decimals"`
+	Balance  string          `json:"balance"`
+	Wei      string          `json:"wei"`
+}
+
+// AccountBalance holds the balances and nonces of an account
+type AccountBalance struct {
+	Address      common.Address `json:"address"`
+	Nonce        uint64         `json:"nonce"`
+	PendingNonce uint64         `json:"pendingNonce"`
+	Balances     []TokenBalance `json:"balances"`
+}
+
+func BalanceCmd(cmd *cobra.Command, args []string, c *evmclient.EVMClient) error {
+	ctx := context.Background()
+	nonce, err := c.NonceAt(ctx, AccountAddr, nil)
+	if err != nil {
+		return err
+	}
+	pendingNonce, err := c.PendingNonceAt(ctx, AccountAddr)
+	if err != nil {
+		return err
+	}
+	celo, err := c.BalanceAt(ctx, AccountAddr, nil)
+	if err != nil {
+		return err
+	}
+	balances := []TokenBalance{newTokenBalance("CELO", nil, celoDecimals, celo)}
+
+	whitelistAddr, err := registry.NewRegistryContract(c, registry.RegistryAddress).GetAddressFor(registry.FeeCurrencyWhitelistID)
+	if err != nil {
+		return fmt.Errorf("failed resolving fee currency whitelist: %w", err)
+	}
+	tokens, err := feecurrencywhitelist.NewFeeCurrencyWhitelistContract(c, whitelistAddr).GetWhitelist()
+	if err != nil {
+		return fmt.Errorf("failed getting fee currency whitelist: %w", err)
+	}
+	for _, token := range tokens {
+		b, err := tokenBalance(c, token)
+		if err != nil {
+			return fmt.Errorf("failed getting balance of token %s: %w", token.Hex(), err)
+		}
+		balances = append(balances, *b)
+	}
+
+	res := AccountBalance{Address: AccountAddr, Nonce: nonce, PendingNonce: pendingNonce, Balances: balances}
+	if JSON {
+		out, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+	printAccountBalance(&res)
+	return nil
+}
+
+func tokenBalance(c *evmclient.EVMClient, token common.Address) (*TokenBalance, error) {
+	t := stabletoken.NewStableTokenContract(c, token)
+	symbol, err := t.Symbol()
+	if err != nil {
+		return nil, err
+	}
+	decimals, err := t.Decimals()
+	if err != nil {
+		return nil, err
+	}
+	balance, err := t.GetBalance(AccountAddr)
+	if err != nil {
+		return nil, err
+	}
+	b := newTokenBalance(symbol, &token, decimals, balance)
+	return &b, nil
+}
+
+func newTokenBalance(symbol string, token *common.Address, decimals uint8, wei *big.Int) TokenBalance {
+	return TokenBalance{
+		Symbol:   symbol,
+		Address:  token,
+		Decimals: decimals,
+		Balance:  formatUnits(wei, decimals),
+		Wei:      wei.String(),
+	}
+}
+
+// formatUnits formats amount as a decimal number with decimals fractional digits, trailing zeros trimmed
+func formatUnits(amount *big.Int, decimals uint8) string {
+	if decimals == 0 {
+		return amount.String()
+	}
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	whole, frac := new(big.Int).QuoRem(new(big.Int).Abs(amount), unit, new(big.Int))
+	s := whole.String()
+	if frac.Sign() != 0 {
+		fracStr := frac.String()
+		fracStr = strings.Repeat("0", int(decimals)-len(fracStr)) + fracStr
+		s += "." + strings.TrimRight(fracStr, "0")
+	}
+	if amount.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+func printAccountBalance(b *AccountBalance) {
+	fmt.Printf("Address:       %s\n", b.Address.Hex())
+	fmt.Printf("Nonce:         %d\n", b.Nonce)
+	fmt.Printf("Pending nonce: %d\n\n", b.PendingNonce)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TOKEN\tBALANCE\tADDRESS")
+	for _, t := range b.Balances {
+		addr := "native"
+		if t.Address != nil {
+			addr = t.Address.Hex()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", t.Symbol, t.Balance, addr)
+	}
+	w.Flush()
+}
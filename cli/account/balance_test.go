@@ -0,0 +1,40 @@
+package account
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BalanceTestSuite struct {
+	suite.Suite
+}
+
+func TestRunBalanceTestSuite(t *testing.T) {
+	suite.Run(t, new(BalanceTestSuite))
+}
+
+func (s *BalanceTestSuite) TestFormatsUnits() {
+	testcases := []struct {
+		amount   string
+		decimals uint8
+		want     string
+	}{
+		{"0", 18, "0"},
+		{"0", 0, "0"},
+		{"1", 18, "0.000000000000000001"},
+		{"500000000000000000", 18, "0.5"},
+		{"1000000000000000000", 18, "1"},
+		{"1230000000000000000", 18, "1.23"},
+		{"1000000", 6, "1"},
+		{"1000100", 6, "1.0001"},
+		{"12345", 0, "12345"},
+		{"-1500000000000000000", 18, "-1.5"},
+		{"-1", 2, "-0.01"},
+	}
+	for _, tc := range testcases {
+		amount, _ := new(big.Int).SetString(tc.amount, 10)
+		s.Equal(tc.want, formatUnits(amount, tc.decimals), tc.amount)
+	}
+}
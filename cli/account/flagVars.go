@@ -0,0 +1,22 @@
+package account
+
+import (
+	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+//flag vars
+var (
+	JSON bool
+)
+
+//processed flag vars
+var (
+	AccountAddr common.Address
+)
+
+// global flags
+var (
+	url           string
+	senderKeyPair *secp256k1.Keypair
+)
@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var addRelayerCmd = &cobra.Command{
+	Use:   "add-relayer",
+	Short: "Add a new relayer",
+	Long:  "The add-relayer subcommand adds a new relayer to the bridge",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return AddRelayerCmd(cmd, args, celoBridge.NewBridgeContract(c, BridgeAddr, t))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateAddRelayerFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessAddRelayerFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindAddRelayerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Relayer, "relayer", "", "Address to add")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	flags.MarkFlagsAsRequired(cmd, "relayer", "bridge")
+}
+
+func init() {
+	BindAddRelayerFlags(addRelayerCmd)
+}
+
+func ValidateAddRelayerFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Relayer) {
+		return fmt.Errorf("invalid relayer address %s", Relayer)
+	}
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	return nil
+}
+
+func ProcessAddRelayerFlags(cmd *cobra.Command, args []string) {
+	RelayerAddr = common.HexToAddress(Relayer)
+	BridgeAddr = common.HexToAddress(Bridge)
+}
+
+func AddRelayerCmd(cmd *cobra.Command, args []string, contract *celoBridge.BridgeContract) error {
+	log.Debug().Msgf(`
+Adding relayer
+Relayer address: %s
+Bridge address: %s`, Relayer, Bridge)
+
+	h, err := contract.AddRelayer(RelayerAddr, transactor.TransactOptions{GasLimit: gasLimit})
+	if err != nil {
+		log.Error().Err(err).Msg("Add relayer error")
+		return err
+	}
+	log.Info().Msgf("Address %s added as relayer in %s", RelayerAddr.String(), h.Hex())
+	return nil
+}
@@ -3,11 +3,11 @@ package admin
 import (
 	"fmt"
 
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
 	"github.com/ChainSafe/chainbridge-celo-module/transaction"
 	bridgeContract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/admin"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/initialize"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
 	"github.com/spf13/cobra"
 )
@@ -19,7 +19,7 @@ var AdminCeloCmd = &cobra.Command{
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		var err error
 		// fetch global flag values
-		url, _, gasPrice, senderKeyPair, prepare, err = flags.GlobalFlagValues(cmd)
+		url, gasLimit, gasPrice, senderKeyPair, prepare, err = flags.GlobalFlagValues(cmd)
 		if err != nil {
 			return fmt.Errorf("could not get global flags: %v", err)
 		}
@@ -114,5 +114,13 @@ func init() {
 	AdminCeloCmd.AddCommand(
 		pauseCmd,
 		unpauseCmd,
+		addRelayerCmd,
+		removeRelayerCmd,
+		isRelayerCmd,
+		setThresholdCmd,
+		setFeeCmd,
+		setDepositNonceCmd,
+		withdrawCmd,
+		changeAdminCmd,
 	)
 }
@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testBridge  = "0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B"
+	testAddress = "0xff93B45308FD417dF303D6515aB04D9e89a750Ca"
+)
+
+type AdminFlagsTestSuite struct {
+	suite.Suite
+}
+
+func TestRunAdminFlagsTestSuite(t *testing.T) {
+	suite.Run(t, new(AdminFlagsTestSuite))
+}
+
+func (s *AdminFlagsTestSuite) SetupTest() {
+	Bridge, Relayer, Admin, Fee, RelayerThreshold = testBridge, testAddress, testAddress, "0.5", 2
+}
+
+func (s *AdminFlagsTestSuite) TestValidateRelayerFlags() {
+	for _, validate := range []func() error{
+		func() error { return ValidateAddRelayerFlags(nil, nil) },
+		func() error { return ValidateRemoveRelayerFlags(nil, nil) },
+		func() error { return ValidateIsRelayerFlags(nil, nil) },
+	} {
+		s.SetupTest()
+		s.Nil(validate())
+
+		Relayer = "0x1234"
+		s.NotNil(validate())
+
+		s.SetupTest()
+		Bridge = "bridge"
+		s.NotNil(validate())
+	}
+}
+
+func (s *AdminFlagsTestSuite) TestProcessRelayerFlags() {
+	ProcessRemoveRelayerFlags(nil, nil)
+	s.Equal(common.HexToAddress(testAddress), RelayerAddr)
+	s.Equal(common.HexToAddress(testBridge), BridgeAddr)
+
+	RelayerAddr, BridgeAddr = common.Address{}, common.Address{}
+	ProcessIsRelayerFlags(nil, nil)
+	s.Equal(common.HexToAddress(testAddress), RelayerAddr)
+	s.Equal(common.HexToAddress(testBridge), BridgeAddr)
+}
+
+func (s *AdminFlagsTestSuite) TestValidateChangeAdminFlags() {
+	s.Nil(ValidateChangeAdminFlags(nil, nil))
+
+	Admin = ""
+	s.NotNil(ValidateChangeAdminFlags(nil, nil))
+
+	s.SetupTest()
+	Bridge = "0x1234"
+	s.NotNil(ValidateChangeAdminFlags(nil, nil))
+}
+
+func (s *AdminFlagsTestSuite) TestSetFeeFlags() {
+	s.Nil(ValidateSetFeeFlags(nil, nil))
+	s.Nil(ProcessSetFeeFlags(nil, nil))
+	s.Equal(big.NewInt(500000000000000000), RealFee)
+
+	Fee = "fee"
+	s.NotNil(ProcessSetFeeFlags(nil, nil))
+
+	Bridge = "bridge"
+	s.NotNil(ValidateSetFeeFlags(nil, nil))
+}
+
+func (s *AdminFlagsTestSuite) TestValidateSetDepositNonceFlags() {
+	s.Nil(ValidateSetDepositNonceFlags(nil, nil))
+
+	Bridge = "0x1234"
+	s.NotNil(ValidateSetDepositNonceFlags(nil, nil))
+}
+
+func (s *AdminFlagsTestSuite) TestValidateSetThresholdFlags() {
+	s.Nil(ValidateSetThresholdFlags(nil, nil))
+
+	RelayerThreshold = 0
+	s.NotNil(ValidateSetThresholdFlags(nil, nil))
+}
@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var changeAdminCmd = &cobra.Command{
+	Use:   "change-admin",
+	Short: "Transfer the admin role to a new address",
+	Long:  "The change-admin subcommand grants the admin role to a new address and renounces it for the sender",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return ChangeAdminCmd(cmd, args, celoBridge.NewBridgeContract(c, BridgeAddr, t))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateChangeAdminFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessChangeAdminFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindChangeAdminFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Admin, "admin", "", "Address of the new admin")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	flags.MarkFlagsAsRequired(cmd, "admin", "bridge")
+}
+
+func init() {
+	BindChangeAdminFlags(changeAdminCmd)
+}
+
+func ValidateChangeAdminFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Admin) {
+		return fmt.Errorf("invalid admin address %s", Admin)
+	}
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	return nil
+}
+
+func ProcessChangeAdminFlags(cmd *cobra.Command, args []string) {
+	AdminAddr = common.HexToAddress(Admin)
+	BridgeAddr = common.HexToAddress(Bridge)
+}
+
+func ChangeAdminCmd(cmd *cobra.Command, args []string, contract *celoBridge.BridgeContract) error {
+	log.Debug().Msgf(`
+Changing admin
+New admin address: %s
+Bridge address: %s`, Admin, Bridge)
+
+	h, err := contract.RenounceAdmin(AdminAddr, transactor.TransactOptions{GasLimit: gasLimit})
+	if err != nil {
+		log.Error().Err(err).Msg("Change admin error")
+		return err
+	}
+	log.Info().Msgf("Admin role transferred to %s in %s", AdminAddr.String(), h.Hex())
+	return nil
+}
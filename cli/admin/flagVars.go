@@ -28,16 +28,19 @@ var (
 //processed flag vars
 var (
 	BridgeAddr    common.Address
+	AdminAddr     common.Address
 	HandlerAddr   common.Address
 	RelayerAddr   common.Address
 	RecipientAddr common.Address
 	TokenAddr     common.Address
 	RealAmount    *big.Int
+	RealFee       *big.Int
 )
 
 // global flags
 var (
 	url           string
+	gasLimit      uint64
 	gasPrice      *big.Int
 	senderKeyPair *secp256k1.Keypair
 	prepare       bool
@@ -0,0 +1,80 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var isRelayerCmd = &cobra.Command{
+	Use:   "is-relayer",
+	Short: "Check if an address is registered as a relayer",
+	Long:  "The is-relayer subcommand checks if an address is registered as a relayer",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return IsRelayerCmd(cmd, args, celoBridge.NewBridgeContract(c, BridgeAddr, nil))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateIsRelayerFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessIsRelayerFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindIsRelayerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Relayer, "relayer", "", "Address to check")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	flags.MarkFlagsAsRequired(cmd, "relayer", "bridge")
+}
+
+func init() {
+	BindIsRelayerFlags(isRelayerCmd)
+}
+
+func ValidateIsRelayerFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Relayer) {
+		return fmt.Errorf("invalid relayer address %s", Relayer)
+	}
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	return nil
+}
+
+func ProcessIsRelayerFlags(cmd *cobra.Command, args []string) {
+	RelayerAddr = common.HexToAddress(Relayer)
+	BridgeAddr = common.HexToAddress(Bridge)
+}
+
+func IsRelayerCmd(cmd *cobra.Command, args []string, contract *celoBridge.BridgeContract) error {
+	log.Debug().Msgf(`
+Checking relayer
+Relayer address: %s
+Bridge address: %s`, Relayer, Bridge)
+
+	isRelayer, err := contract.IsRelayer(RelayerAddr)
+	if err != nil {
+		return err
+	}
+	if !isRelayer {
+		log.Info().Msgf("Address %s is NOT relayer", RelayerAddr.String())
+	} else {
+		log.Info().Msgf("Address %s is relayer", RelayerAddr.String())
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var removeRelayerCmd = &cobra.Command{
+	Use:   "remove-relayer",
+	Short: "Remove a relayer",
+	Long:  "The remove-relayer subcommand removes a relayer from the bridge",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return RemoveRelayerCmd(cmd, args, celoBridge.NewBridgeContract(c, BridgeAddr, t))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateRemoveRelayerFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessRemoveRelayerFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindRemoveRelayerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Relayer, "relayer", "", "Address to remove")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	flags.MarkFlagsAsRequired(cmd, "relayer", "bridge")
+}
+
+func init() {
+	BindRemoveRelayerFlags(removeRelayerCmd)
+}
+
+func ValidateRemoveRelayerFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Relayer) {
+		return fmt.Errorf("invalid relayer address %s", Relayer)
+	}
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	return nil
+}
+
+func ProcessRemoveRelayerFlags(cmd *cobra.Command, args []string) {
+	RelayerAddr = common.HexToAddress(Relayer)
+	BridgeAddr = common.HexToAddress(Bridge)
+}
+
+func RemoveRelayerCmd(cmd *cobra.Command, args []string, contract *celoBridge.BridgeContract) error {
+	log.Debug().Msgf(`
+Removing relayer
+Relayer address: %s
+Bridge address: %s`, Relayer, Bridge)
+
+	h, err := contract.RemoveRelayer(RelayerAddr, transactor.TransactOptions{GasLimit: gasLimit})
+	if err != nil {
+		log.Error().Err(err).Msg("Remove relayer error")
+		return err
+	}
+	log.Info().Msgf("Address %s removed as relayer in %s", RelayerAddr.String(), h.Hex())
+	return nil
+}
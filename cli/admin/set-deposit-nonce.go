@@ -0,0 +1,81 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var setDepositNonceCmd = &cobra.Command{
+	Use:   "set-deposit-nonce",
+	Short: "Set the deposit nonce",
+	Long:  "The set-deposit-nonce subcommand sets the deposit nonce. This nonce cannot be less than what is currently stored in the contract",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return SetDepositNonceCmd(cmd, args, celoBridge.NewBridgeContract(c, BridgeAddr, t))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateSetDepositNonceFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessSetDepositNonceFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindSetDepositNonceFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint8Var(&DomainID, "domain", 0, "Domain ID of chain")
+	cmd.Flags().Uint64Var(&DepositNonce, "deposit-nonce", 0, "Deposit nonce to set (does not decrement)")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	flags.MarkFlagsAsRequired(cmd, "domain", "deposit-nonce", "bridge")
+}
+
+func init() {
+	BindSetDepositNonceFlags(setDepositNonceCmd)
+}
+
+func ValidateSetDepositNonceFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	return nil
+}
+
+func ProcessSetDepositNonceFlags(cmd *cobra.Command, args []string) {
+	BridgeAddr = common.HexToAddress(Bridge)
+}
+
+func SetDepositNonceCmd(cmd *cobra.Command, args []string, contract *celoBridge.BridgeContract) error {
+	log.Debug().Msgf(`
+Set Deposit Nonce
+Domain ID: %v
+Deposit Nonce: %v
+Bridge Address: %s`, DomainID, DepositNonce, Bridge)
+
+	h, err := contract.SetDepositNonce(DomainID, DepositNonce, transactor.TransactOptions{GasLimit: gasLimit})
+	if err != nil {
+		log.Error().Err(err).Msg("Set deposit nonce error")
+		return err
+	}
+	log.Info().Msgf("[domain ID: %v] successfully set nonce: %v at address: %s in %s", DomainID, DepositNonce, BridgeAddr.String(), h.Hex())
+	return nil
+}
@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	callsUtil "github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var setFeeCmd = &cobra.Command{
+	Use:   "set-fee",
+	Short: "Set a new fee for deposits",
+	Long:  "The set-fee subcommand sets a new fee for deposits",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return SetFeeCmd(cmd, args, celoBridge.NewBridgeContract(c, BridgeAddr, t))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateSetFeeFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		err = ProcessSetFeeFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return nil
+	},
+}
+
+func BindSetFeeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Fee, "fee", "", "New fee (in CELO)")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	flags.MarkFlagsAsRequired(cmd, "fee", "bridge")
+}
+
+func init() {
+	BindSetFeeFlags(setFeeCmd)
+}
+
+func ValidateSetFeeFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	return nil
+}
+
+func ProcessSetFeeFlags(cmd *cobra.Command, args []string) error {
+	var err error
+	BridgeAddr = common.HexToAddress(Bridge)
+	RealFee, err = callsUtil.UserAmountToWei(Fee, big.NewInt(18))
+	return err
+}
+
+func SetFeeCmd(cmd *cobra.Command, args []string, contract *celoBridge.BridgeContract) error {
+	log.Debug().Msgf(`
+Setting new fee
+Fee amount: %s
+Bridge address: %s`, Fee, Bridge)
+
+	h, err := contract.ChangeFee(RealFee, transactor.TransactOptions{GasLimit: gasLimit})
+	if err != nil {
+		log.Error().Err(err).Msg("Set fee error")
+		return err
+	}
+	log.Info().Msgf("Fee set to %s in %s", RealFee.String(), h.Hex())
+	return nil
+}
@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var setThresholdCmd = &cobra.Command{
+	Use:   "set-threshold",
+	Short: "Set a new relayer vote threshold",
+	Long:  "The set-threshold subcommand sets a new relayer vote threshold",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return SetThresholdCmd(cmd, args, celoBridge.NewBridgeContract(c, BridgeAddr, t))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateSetThresholdFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessSetThresholdFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindSetThresholdFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64Var(&RelayerThreshold, "threshold", 0, "New relayer threshold")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	flags.MarkFlagsAsRequired(cmd, "threshold", "bridge")
+}
+
+func init() {
+	BindSetThresholdFlags(setThresholdCmd)
+}
+
+func ValidateSetThresholdFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	if RelayerThreshold == 0 {
+		return errors.New("threshold must be greater than zero")
+	}
+	return nil
+}
+
+func ProcessSetThresholdFlags(cmd *cobra.Command, args []string) {
+	BridgeAddr = common.HexToAddress(Bridge)
+}
+
+func SetThresholdCmd(cmd *cobra.Command, args []string, contract *celoBridge.BridgeContract) error {
+	log.Debug().Msgf(`
+Setting new threshold
+Threshold: %d
+Bridge address: %s`, RelayerThreshold, Bridge)
+
+	h, err := contract.SetThresholdInput(RelayerThreshold, transactor.TransactOptions{GasLimit: gasLimit})
+	if err != nil {
+		log.Error().Err(err).Msg("Set threshold error")
+		return err
+	}
+	log.Info().Msgf("New threshold set to %d in %s", RelayerThreshold, h.Hex())
+	return nil
+}
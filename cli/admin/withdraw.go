@@ -0,0 +1,129 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	callsUtil "github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var withdrawCmd = &cobra.Command{
+	Use:   "withdraw",
+	Short: "Withdraw tokens from a handler contract",
+	Long:  "The withdraw subcommand withdraws ERC20 or ERC721 tokens from a handler contract",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return WithdrawCmd(cmd, args, celoBridge.NewBridgeContract(c, BridgeAddr, t))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateWithdrawFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		err = ProcessWithdrawFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return nil
+	},
+}
+
+func BindWithdrawFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Amount, "amount", "", "Token amount to withdraw, use only if ERC20 token is withdrawn. If both amount and token-id are set an error will occur")
+	cmd.Flags().StringVar(&TokenID, "token-id", "", "Token ID to withdraw, use only if ERC721 token is withdrawn. If both amount and token-id are set an error will occur")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	cmd.Flags().StringVar(&Handler, "handler", "", "Handler contract address")
+	cmd.Flags().StringVar(&Token, "token-contract", "", "ERC20 or ERC721 token contract address")
+	cmd.Flags().StringVar(&Recipient, "recipient", "", "Address to withdraw to")
+	cmd.Flags().Uint64Var(&Decimals, "decimals", 0, "ERC20 token decimals, required with amount")
+	flags.MarkFlagsAsRequired(cmd, "bridge", "handler", "token-contract", "recipient")
+}
+
+func init() {
+	BindWithdrawFlags(withdrawCmd)
+}
+
+func ValidateWithdrawFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	if !common.IsHexAddress(Handler) {
+		return fmt.Errorf("invalid handler address %s", Handler)
+	}
+	if !common.IsHexAddress(Token) {
+		return fmt.Errorf("invalid token-contract address %s", Token)
+	}
+	if !common.IsHexAddress(Recipient) {
+		return fmt.Errorf("invalid recipient address %s", Recipient)
+	}
+	if TokenID != "" && Amount != "" {
+		return errors.New("only token-id or amount should be set")
+	}
+	if TokenID == "" && Amount == "" {
+		return errors.New("token-id or amount flag should be set")
+	}
+	if Amount != "" && !cmd.Flags().Changed("decimals") {
+		return errors.New("decimals flag should be set with amount")
+	}
+	return nil
+}
+
+func ProcessWithdrawFlags(cmd *cobra.Command, args []string) error {
+	var err error
+	BridgeAddr = common.HexToAddress(Bridge)
+	HandlerAddr = common.HexToAddress(Handler)
+	TokenAddr = common.HexToAddress(Token)
+	RecipientAddr = common.HexToAddress(Recipient)
+
+	if TokenID != "" {
+		var ok bool
+		RealAmount, ok = new(big.Int).SetString(TokenID, 10)
+		if !ok {
+			return fmt.Errorf("invalid token id %s", TokenID)
+		}
+		return nil
+	}
+	RealAmount, err = callsUtil.UserAmountToWei(Amount, big.NewInt(int64(Decimals)))
+	return err
+}
+
+func WithdrawCmd(cmd *cobra.Command, args []string, contract *celoBridge.BridgeContract) error {
+	log.Debug().Msgf(`
+Withdrawing tokens
+Amount: %s
+Token ID: %s
+Handler address: %s
+Token address: %s
+Recipient address: %s
+Bridge address: %s`, Amount, TokenID, Handler, Token, Recipient, Bridge)
+
+	h, err := contract.Withdraw(
+		HandlerAddr, TokenAddr, RecipientAddr, RealAmount, transactor.TransactOptions{GasLimit: gasLimit},
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("Admin withdrawal error")
+		return err
+	}
+	log.Info().Msgf("%s tokens were withdrawn from handler contract %s into recipient %s; tx hash: %s", RealAmount.String(), Handler, Recipient, h.Hex())
+	return nil
+}
@@ -0,0 +1,78 @@
+package admin
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+)
+
+type WithdrawFlagsTestSuite struct {
+	suite.Suite
+	cmd *cobra.Command
+}
+
+func TestRunWithdrawFlagsTestSuite(t *testing.T) {
+	suite.Run(t, new(WithdrawFlagsTestSuite))
+}
+
+func (s *WithdrawFlagsTestSuite) SetupTest() {
+	Amount, TokenID, Decimals = "", "", 0
+	s.cmd = &cobra.Command{}
+	BindWithdrawFlags(s.cmd)
+	s.set("bridge", testBridge)
+	s.set("handler", "0x3167776db165D8eA0f51790CA2bbf44Db5105ADF")
+	s.set("token-contract", "0xF194afDf50B03e69Bd7D057c1Aa9e10c9954E4C9")
+	s.set("recipient", testAddress)
+}
+
+func (s *WithdrawFlagsTestSuite) set(name, value string) {
+	s.Require().Nil(s.cmd.Flags().Set(name, value))
+}
+
+func (s *WithdrawFlagsTestSuite) TestWithdrawsERC20Amount() {
+	s.set("amount", "1.5")
+	s.set("decimals", "6")
+	s.Nil(ValidateWithdrawFlags(s.cmd, nil))
+	s.Nil(ProcessWithdrawFlags(s.cmd, nil))
+	s.Equal(big.NewInt(1500000), RealAmount)
+	s.Equal(common.HexToAddress("0xF194afDf50B03e69Bd7D057c1Aa9e10c9954E4C9"), TokenAddr)
+}
+
+func (s *WithdrawFlagsTestSuite) TestWithdrawsERC721TokenID() {
+	s.set("token-id", "42")
+	s.Nil(ValidateWithdrawFlags(s.cmd, nil))
+	s.Nil(ProcessWithdrawFlags(s.cmd, nil))
+	s.Equal(big.NewInt(42), RealAmount)
+}
+
+func (s *WithdrawFlagsTestSuite) TestFailsOnInvalidTokenID() {
+	s.set("token-id", "0x2a")
+	s.Nil(ValidateWithdrawFlags(s.cmd, nil))
+	s.EqualError(ProcessWithdrawFlags(s.cmd, nil), "invalid token id 0x2a")
+}
+
+func (s *WithdrawFlagsTestSuite) TestRequiresAmountOrTokenID() {
+	s.EqualError(ValidateWithdrawFlags(s.cmd, nil), "token-id or amount flag should be set")
+
+	s.set("amount", "1")
+	s.set("decimals", "18")
+	s.set("token-id", "1")
+	s.EqualError(ValidateWithdrawFlags(s.cmd, nil), "only token-id or amount should be set")
+}
+
+func (s *WithdrawFlagsTestSuite) TestRequiresDecimalsWithAmount() {
+	s.set("amount", "1")
+	s.EqualError(ValidateWithdrawFlags(s.cmd, nil), "decimals flag should be set with amount")
+}
+
+func (s *WithdrawFlagsTestSuite) TestValidatesAddresses() {
+	for _, flag := range []string{"bridge", "handler", "token-contract", "recipient"} {
+		s.SetupTest()
+		s.set("token-id", "1")
+		s.set(flag, "0x1234")
+		s.NotNil(ValidateWithdrawFlags(s.cmd, nil), flag)
+	}
+}
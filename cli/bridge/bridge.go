@@ -3,11 +3,11 @@ package bridge
 import (
 	"fmt"
 
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
 	"github.com/ChainSafe/chainbridge-celo-module/transaction"
 	bridgeContract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/bridge"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/initialize"
 
 	"github.com/spf13/cobra"
 )
@@ -19,7 +19,7 @@ var BridgeCeloCmd = &cobra.Command{
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		var err error
 		// fetch global flag values
-		url, _, gasPrice, senderKeyPair, prepare, err = flags.GlobalFlagValues(cmd)
+		url, gasLimit, gasPrice, senderKeyPair, prepare, err = flags.GlobalFlagValues(cmd)
 		if err != nil {
 			return fmt.Errorf("could not get global flags: %v", err)
 		}
@@ -101,15 +101,20 @@ var setBurnCmd = &cobra.Command{
 	},
 }
 
-// TODO:
-// cancel-proposal
-// query-proposal
-// query-resource
-// register-generic-resource
-
 func init() {
 	bridge.BindRegisterResourceFlags(registerResourceCmd)
 	bridge.BindSetBurnFlags(setBurnCmd)
 
-	BridgeCeloCmd.AddCommand(registerResourceCmd, setBurnCmd)
+	BridgeCeloCmd.AddCommand(
+		registerResourceCmd,
+		setBurnCmd,
+		cancelProposalCmd,
+		queryProposalCmd,
+		queryResourceCmd,
+		registerGenericResourceCmd,
+		trackCmd,
+		eventsCmd,
+		statusCmd,
+		reconcileCmd,
+	)
 }
@@ -0,0 +1,84 @@
+package bridge
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var cancelProposalCmd = &cobra.Command{
+	Use:   "cancel-proposal",
+	Short: "Cancel an expired proposal",
+	Long:  "The cancel-proposal subcommand cancels an expired proposal",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return CancelProposalCmd(cmd, args, celoBridge.NewBridgeContract(c, BridgeAddr, t))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateCancelProposalFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessCancelProposalFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindCancelProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	cmd.Flags().StringVar(&DataHash, "data-hash", "", "Hash of proposal metadata")
+	cmd.Flags().Uint8Var(&DomainID, "domain", 0, "Domain ID of proposal to cancel")
+	cmd.Flags().Uint64Var(&DepositNonce, "deposit-nonce", 0, "Deposit nonce of proposal to cancel")
+	flags.MarkFlagsAsRequired(cmd, "bridge", "data-hash", "domain", "deposit-nonce")
+}
+
+func init() {
+	BindCancelProposalFlags(cancelProposalCmd)
+}
+
+func ValidateCancelProposalFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address: %s", Bridge)
+	}
+	return validateDataHash(DataHash)
+}
+
+func ProcessCancelProposalFlags(cmd *cobra.Command, args []string) {
+	BridgeAddr = common.HexToAddress(Bridge)
+	DataHashBytes = common.HexToHash(DataHash)
+}
+
+func CancelProposalCmd(cmd *cobra.Command, args []string, contract *celoBridge.BridgeContract) error {
+	h, err := contract.CancelProposal(DomainID, DepositNonce, DataHashBytes, transactor.TransactOptions{GasLimit: gasLimit})
+	if err != nil {
+		log.Error().Err(err).Msg("Failed cancelling proposal")
+		return err
+	}
+	log.Info().Msgf("Proposal with domain ID %d and deposit nonce %d canceled in %s", DomainID, DepositNonce, h.Hex())
+	return nil
+}
+
+func validateDataHash(dataHash string) error {
+	if len(common.FromHex(dataHash)) != common.HashLength {
+		return fmt.Errorf("invalid data hash: %s", dataHash)
+	}
+	return nil
+}
@@ -0,0 +1,358 @@
+package bridge
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoClient "github.com/ChainSafe/chainbridge-celo-module/client"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+)
+
+// Types of listed events
+const (
+	EventTypeDeposit  = "deposit"
+	EventTypeProposal = "proposal"
+	EventTypeVote     = "vote"
+)
+
+// Output formats of listed events
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+	FormatCSV   = "csv"
+)
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "List bridge events in a block range",
+	Long:  "The events subcommand lists the Deposit, ProposalEvent or ProposalVote events of a bridge in a block range as a table, JSON lines or CSV",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return EventsCmd(cmd, args, c)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateEventsFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessEventsFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindEventsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	cmd.Flags().Uint64Var(&FromBlock, "from-block", 0, "First block of the range")
+	cmd.Flags().Uint64Var(&ToBlock, "to-block", 0, "Last block of the range, the latest block if not set")
+	cmd.Flags().StringVar(&EventType, "type", EventTypeDeposit, "Type of the listed events (deposit, proposal or vote)")
+	cmd.Flags().StringVar(&Format, "format", FormatTable, "Output format (table, json or csv); json prints one event per line")
+	cmd.Flags().StringVar(&ResourceID, "resource-id", "", "Only list deposits of this resource ID")
+	cmd.Flags().StringVar(&Depositor, "depositor", "", "Only list deposits of this depositor")
+	cmd.Flags().Uint8Var(&DestDomainID, "destination-domain", 0, "Only list deposits to this destination domain ID")
+	cmd.Flags().Uint64Var(&DepositNonce, "deposit-nonce", 0, "Only list events of this deposit nonce")
+	cmd.Flags().Uint64Var(&Chunk, "chunk", celoClient.DefaultLogsChunk, "Maximum number of blocks queried per request")
+	flags.MarkFlagsAsRequired(cmd, "bridge")
+}
+
+func init() {
+	BindEventsFlags(eventsCmd)
+}
+
+func ValidateEventsFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address: %s", Bridge)
+	}
+	switch EventType {
+	case EventTypeDeposit:
+	case EventTypeProposal, EventTypeVote:
+		if ResourceID != "" || Depositor != "" || cmd.Flags().Changed("destination-domain") {
+			return errors.New("resource-id, depositor and destination-domain filters only apply to deposits")
+		}
+	default:
+		return fmt.Errorf("invalid event type: %s", EventType)
+	}
+	switch Format {
+	case FormatTable, FormatJSON, FormatCSV:
+	default:
+		return fmt.Errorf("invalid format: %s", Format)
+	}
+	if ResourceID != "" && len(common.FromHex(ResourceID)) != 32 {
+		return fmt.Errorf("invalid resource ID: %s", ResourceID)
+	}
+	if Depositor != "" && !common.IsHexAddress(Depositor) {
+		return fmt.Errorf("invalid depositor address: %s", Depositor)
+	}
+	if cmd.Flags().Changed("to-block") && ToBlock < FromBlock {
+		return errors.New("to-block should not be lower than from-block")
+	}
+	return nil
+}
+
+func ProcessEventsFlags(cmd *cobra.Command, args []string) {
+	BridgeAddr = common.HexToAddress(Bridge)
+	copy(ResourceIdBytesArr[:], common.FromHex(ResourceID))
+	DepositorAddr = nil
+	if Depositor != "" {
+		depositor := common.HexToAddress(Depositor)
+		DepositorAddr = &depositor
+	}
+	DestDomainIDFilter = nil
+	if cmd.Flags().Changed("destination-domain") {
+		DestDomainIDFilter = &DestDomainID
+	}
+	DepositNonceFilter = nil
+	if cmd.Flags().Changed("deposit-nonce") {
+		DepositNonceFilter = &DepositNonce
+	}
+}
+
+// eventRecord is a listed event that can be printed as a table or CSV row
+type eventRecord interface {
+	header() []string
+	row() []string
+}
+
+// DepositRecord is a listed Deposit event
+type DepositRecord struct {
+	BlockNumber         uint64         `json:"blockNumber"`
+	TxHash              common.Hash    `json:"txHash"`
+	DestinationDomainID uint8          `json:"destinationDomainId"`
+	ResourceID          hexutil.Bytes  `json:"resourceId"`
+	DepositNonce        uint64         `json:"depositNonce"`
+	Depositor           common.Address `json:"depositor"`
+	Data                hexutil.Bytes  `json:"data"`
+	HandlerResponse     hexutil.Bytes  `json:"handlerResponse"`
+}
+
+func (r *DepositRecord) header() []string {
+	return []string{"BLOCK", "TX", "DESTINATION", "RESOURCE ID", "NONCE", "DEPOSITOR", "DATA"}
+}
+
+func (r *DepositRecord) row() []string {
+	return []string{
+		strconv.FormatUint(r.BlockNumber, 10),
+		r.TxHash.Hex(),
+		strconv.Itoa(int(r.DestinationDomainID)),
+		r.ResourceID.String(),
+		strconv.FormatUint(r.DepositNonce, 10),
+		r.Depositor.Hex(),
+		r.Data.String(),
+	}
+}
+
+// ProposalRecord is a listed ProposalEvent or ProposalVote event
+type ProposalRecord struct {
+	BlockNumber    uint64      `json:"blockNumber"`
+	TxHash         common.Hash `json:"txHash"`
+	OriginDomainID uint8       `json:"originDomainId"`
+	DepositNonce   uint64      `json:"depositNonce"`
+	Status         string      `json:"status"`
+	DataHash       common.Hash `json:"dataHash"`
+}
+
+func (r *ProposalRecord) header() []string {
+	return []string{"BLOCK", "TX", "ORIGIN", "NONCE", "STATUS", "DATA HASH"}
+}
+
+func (r *ProposalRecord) row() []string {
+	return []string{
+		strconv.FormatUint(r.BlockNumber, 10),
+		r.TxHash.Hex(),
+		strconv.Itoa(int(r.OriginDomainID)),
+		strconv.FormatUint(r.DepositNonce, 10),
+		r.Status,
+		r.DataHash.Hex(),
+	}
+}
+
+// EventsClient queries the logs and latest block of a chain
+type EventsClient interface {
+	ethereum.LogFilterer
+	LatestBlock() (*big.Int, error)
+}
+
+func EventsCmd(cmd *cobra.Command, args []string, c EventsClient) error {
+	to := ToBlock
+	if !cmd.Flags().Changed("to-block") {
+		latest, err := c.LatestBlock()
+		if err != nil {
+			return err
+		}
+		to = latest.Uint64()
+	}
+
+	w := newEventWriter(os.Stdout, Format)
+	err := celoClient.FilterLogsInChunks(context.Background(), c, eventsQuery(), FromBlock, to, Chunk, func(logs []types.Log) error {
+		for _, l := range logs {
+			r, err := toEventRecord(l)
+			if err != nil {
+				return err
+			}
+			if r == nil {
+				continue
+			}
+			if err := w.write(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+func eventsQuery() ethereum.FilterQuery {
+	q := ethereum.FilterQuery{Addresses: []common.Address{BridgeAddr}}
+	switch EventType {
+	case EventTypeDeposit:
+		q.Topics = [][]common.Hash{{util.Deposit.GetTopic()}}
+		// the depositor is the only indexed event argument, so it is filtered by the node
+		if DepositorAddr != nil {
+			q.Topics = append(q.Topics, []common.Hash{common.BytesToHash(DepositorAddr.Bytes())})
+		}
+	case EventTypeProposal:
+		q.Topics = [][]common.Hash{{util.ProposalEvent.GetTopic()}}
+	case EventTypeVote:
+		q.Topics = [][]common.Hash{{util.ProposalVote.GetTopic()}}
+	}
+	return q
+}
+
+// toEventRecord unpacks l and returns nil if it doesn't match the filters
+func toEventRecord(l types.Log) (eventRecord, error) {
+	if EventType != EventTypeDeposit {
+		e, err := celoBridge.UnpackProposalEvent(l)
+		if err != nil {
+			return nil, err
+		}
+		if DepositNonceFilter != nil && e.DepositNonce != *DepositNonceFilter {
+			return nil, nil
+		}
+		return &ProposalRecord{
+			BlockNumber:    e.BlockNumber,
+			TxHash:         e.TxHash,
+			OriginDomainID: e.OriginDomainID,
+			DepositNonce:   e.DepositNonce,
+			Status:         message.StatusMap[e.Status],
+			DataHash:       e.DataHash,
+		}, nil
+	}
+
+	e, err := celoBridge.UnpackDepositEvent(l)
+	if err != nil {
+		return nil, err
+	}
+	if ResourceID != "" && e.ResourceID != ResourceIdBytesArr {
+		return nil, nil
+	}
+	if DestDomainIDFilter != nil && e.DestinationDomainID != *DestDomainIDFilter {
+		return nil, nil
+	}
+	if DepositNonceFilter != nil && e.DepositNonce != *DepositNonceFilter {
+		return nil, nil
+	}
+	return &DepositRecord{
+		BlockNumber:         e.BlockNumber,
+		TxHash:              e.TxHash,
+		DestinationDomainID: e.DestinationDomainID,
+		ResourceID:          e.ResourceID[:],
+		DepositNonce:        e.DepositNonce,
+		Depositor:           e.User,
+		Data:                e.Data,
+		HandlerResponse:     e.HandlerResponse,
+	}, nil
+}
+
+// eventWriter prints event records in one of the output formats. JSON lines and
+// CSV are streamed, the table is printed on flush once column widths are known.
+type eventWriter struct {
+	format  string
+	out     io.Writer
+	table   *tabwriter.Writer
+	csv     *csv.Writer
+	written bool
+}
+
+func newEventWriter(out io.Writer, format string) *eventWriter {
+	w := &eventWriter{format: format, out: out}
+	switch format {
+	case FormatTable:
+		w.table = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	case FormatCSV:
+		w.csv = csv.NewWriter(out)
+	}
+	return w
+}
+
+func (w *eventWriter) write(r eventRecord) error {
+	switch w.format {
+	case FormatJSON:
+		out, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w.out, string(out))
+		return err
+	case FormatCSV:
+		if !w.written {
+			w.written = true
+			header := r.header()
+			for i := range header {
+				header[i] = strings.ToLower(strings.ReplaceAll(header[i], " ", "_"))
+			}
+			if err := w.csv.Write(header); err != nil {
+				return err
+			}
+		}
+		if err := w.csv.Write(r.row()); err != nil {
+			return err
+		}
+		w.csv.Flush()
+		return w.csv.Error()
+	default:
+		if !w.written {
+			w.written = true
+			fmt.Fprintln(w.table, strings.Join(r.header(), "\t"))
+		}
+		_, err := fmt.Fprintln(w.table, strings.Join(r.row(), "\t"))
+		return err
+	}
+}
+
+func (w *eventWriter) flush() error {
+	if w.format != FormatTable {
+		return nil
+	}
+	if !w.written {
+		_, err := fmt.Fprintln(w.out, "No events found")
+		return err
+	}
+	return w.table.Flush()
+}
@@ -0,0 +1,172 @@
+package bridge
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	eventsResource  = common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000100")
+	eventsDepositor = common.HexToAddress("0x829bd824b016326a401d083b33d092293333a830")
+)
+
+// depositLog returns a Deposit log of bridge
+func depositLog(bridge common.Address, block uint64, destination uint8, resourceID common.Hash, nonce uint64, depositor common.Address) types.Log {
+	bridgeABI, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	data, err := bridgeABI.Events["Deposit"].Inputs.NonIndexed().Pack(destination, resourceID, nonce, []byte{0x01}, []byte{})
+	if err != nil {
+		panic(err)
+	}
+	return types.Log{
+		Address:     bridge,
+		Topics:      []common.Hash{util.Deposit.GetTopic(), common.BytesToHash(depositor.Bytes())},
+		Data:        data,
+		BlockNumber: block,
+		TxHash:      common.HexToHash("0xa"),
+	}
+}
+
+type EventsTestSuite struct {
+	suite.Suite
+	chain  *fakeChain
+	bridge common.Address
+}
+
+func TestRunEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(EventsTestSuite))
+}
+
+func (s *EventsTestSuite) SetupTest() {
+	s.bridge = common.HexToAddress("0xb")
+	s.chain = &fakeChain{times: make([]uint64, 100)}
+	s.chain.logs = []types.Log{
+		depositLog(s.bridge, 10, 2, eventsResource, 1, eventsDepositor),
+		depositLog(s.bridge, 11, 2, common.HexToHash("0x1"), 2, eventsDepositor),
+		depositLog(s.bridge, 12, 3, eventsResource, 3, common.HexToAddress("0xd")),
+		depositLog(common.HexToAddress("0xee"), 13, 2, eventsResource, 4, eventsDepositor),
+		proposalLog(s.bridge, 20, false, 1, 1, 1),
+		proposalLog(s.bridge, 21, true, 1, 1, 1),
+		proposalLog(s.bridge, 22, true, 1, 2, 1),
+	}
+}
+
+// runEvents runs the events command with flags and returns its output
+func (s *EventsTestSuite) runEvents(flags ...string) (string, error) {
+	cmd := &cobra.Command{}
+	BindEventsFlags(cmd)
+	s.Nil(cmd.ParseFlags(append([]string{"--bridge", s.bridge.Hex()}, flags...)))
+	if err := ValidateEventsFlags(cmd, nil); err != nil {
+		return "", err
+	}
+	ProcessEventsFlags(cmd, nil)
+	return captureStdout(func() error {
+		return EventsCmd(cmd, nil, s.chain)
+	})
+}
+
+// depositNonces returns the deposit nonces of the JSON lines in out
+func (s *EventsTestSuite) depositNonces(out string) []uint64 {
+	var nonces []uint64
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		if line == "" {
+			continue
+		}
+		var r struct {
+			DepositNonce uint64 `json:"depositNonce"`
+		}
+		s.Nil(json.Unmarshal([]byte(line), &r), line)
+		nonces = append(nonces, r.DepositNonce)
+	}
+	return nonces
+}
+
+func (s *EventsTestSuite) TestListsDepositsOfBridge() {
+	out, err := s.runEvents("--format", "json")
+	s.Nil(err)
+	s.Equal([]uint64{1, 2, 3}, s.depositNonces(out))
+}
+
+func (s *EventsTestSuite) TestFiltersDepositsByResource() {
+	out, err := s.runEvents("--format", "json", "--resource-id", eventsResource.Hex())
+	s.Nil(err)
+	s.Equal([]uint64{1, 3}, s.depositNonces(out))
+}
+
+func (s *EventsTestSuite) TestFiltersDepositsByDepositor() {
+	out, err := s.runEvents("--format", "json", "--depositor", eventsDepositor.Hex())
+	s.Nil(err)
+	s.Equal([]uint64{1, 2}, s.depositNonces(out))
+}
+
+func (s *EventsTestSuite) TestFiltersDepositsByDestination() {
+	out, err := s.runEvents("--format", "json", "--destination-domain", "3")
+	s.Nil(err)
+	s.Equal([]uint64{3}, s.depositNonces(out))
+}
+
+func (s *EventsTestSuite) TestFiltersEventsByNonce() {
+	out, err := s.runEvents("--format", "json", "--deposit-nonce", "2")
+	s.Nil(err)
+	s.Equal([]uint64{2}, s.depositNonces(out))
+
+	out, err = s.runEvents("--format", "json", "--type", "vote", "--deposit-nonce", "1")
+	s.Nil(err)
+	s.Equal([]uint64{1}, s.depositNonces(out))
+}
+
+func (s *EventsTestSuite) TestFiltersByBlockRange() {
+	out, err := s.runEvents("--format", "json", "--from-block", "11", "--to-block", "11")
+	s.Nil(err)
+	s.Equal([]uint64{2}, s.depositNonces(out))
+}
+
+func (s *EventsTestSuite) TestRejectsDepositFiltersForProposals() {
+	_, err := s.runEvents("--type", "proposal", "--depositor", eventsDepositor.Hex())
+	s.NotNil(err)
+}
+
+func (s *EventsTestSuite) TestWritesJSONLines() {
+	out, err := s.runEvents("--format", "json", "--type", "vote")
+	s.Nil(err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	s.Len(lines, 2)
+	var r ProposalRecord
+	s.Nil(json.Unmarshal([]byte(lines[0]), &r))
+	s.Equal(uint64(21), r.BlockNumber)
+	s.Equal(uint8(1), r.OriginDomainID)
+	s.Equal("active", r.Status)
+	s.Equal(common.HexToHash("0xd"), r.DataHash)
+}
+
+func (s *EventsTestSuite) TestWritesCSV() {
+	out, err := s.runEvents("--format", "csv", "--resource-id", eventsResource.Hex())
+	s.Nil(err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	s.Len(lines, 3)
+	s.Equal("block,tx,destination,resource_id,nonce,depositor,data", lines[0])
+	s.Equal("10,"+common.HexToHash("0xa").Hex()+",2,"+eventsResource.Hex()+",1,"+eventsDepositor.Hex()+",0x01", lines[1])
+}
+
+func (s *EventsTestSuite) TestWritesTable() {
+	out, err := s.runEvents("--type", "proposal")
+	s.Nil(err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	s.Len(lines, 2)
+	s.Equal([]string{"BLOCK", "TX", "ORIGIN", "NONCE", "STATUS", "DATA", "HASH"}, strings.Fields(lines[0]))
+	s.Equal([]string{"20", common.HexToHash("0x14").Hex(), "1", "1", "active", common.HexToHash("0xd").Hex()}, strings.Fields(lines[1]))
+}
+
+func (s *EventsTestSuite) TestWritesTableWithoutEvents() {
+	out, err := s.runEvents("--from-block", "50")
+	s.Nil(err)
+	s.Equal("No events found\n", out)
+}
@@ -23,6 +23,23 @@ var (
 	Execute         string
 	Hash            bool
 	TokenContract   string
+	JSON            bool
+	SourceTx        string
+	DestURL         string
+	DestBridge      string
+	DestFromBlock   uint64
+	FromBlock       uint64
+	ToBlock         uint64
+	EventType       string
+	Format          string
+	Depositor       string
+	DestDomainID    uint8
+	Chunk           uint64
+	ConfigPath      string
+	Domains         []uint
+	ResourceIDs     []string
+	DestToBlock     uint64
+	GraceBlocks     uint64
 )
 
 //processed flag vars
@@ -35,11 +52,20 @@ var (
 	DepositSigBytes    [4]byte
 	ExecuteSigBytes    [4]byte
 	DataBytes          []byte
+	DataHashBytes      common.Hash
+	SourceTxHash       common.Hash
+	DestBridgeAddr     common.Address
+	DepositorAddr      *common.Address
+	DestDomainIDFilter *uint8
+	DepositNonceFilter *uint64
+	StatusTargets      []statusTarget
+	StatusDomains      []uint8
 )
 
 // global flags
 var (
 	url           string
+	gasLimit      uint64
 	gasPrice      *big.Int
 	senderKeyPair *secp256k1.Keypair
 	prepare       bool
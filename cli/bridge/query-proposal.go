@@ -0,0 +1,118 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+)
+
+var queryProposalCmd = &cobra.Command{
+	Use:   "query-proposal",
+	Short: "Query an inbound proposal",
+	Long:  "The query-proposal subcommand queries the status and votes of an inbound proposal",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return QueryProposalCmd(cmd, args, celoBridge.NewBridgeContract(c, BridgeAddr, nil))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateQueryProposalFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessQueryProposalFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindQueryProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	cmd.Flags().StringVar(&DataHash, "data-hash", "", "Hash of proposal metadata")
+	cmd.Flags().Uint8Var(&DomainID, "domain", 0, "Source domain ID of proposal")
+	cmd.Flags().Uint64Var(&DepositNonce, "deposit-nonce", 0, "Deposit nonce of proposal")
+	cmd.Flags().BoolVar(&JSON, "json", false, "Print the result as JSON")
+	flags.MarkFlagsAsRequired(cmd, "bridge", "data-hash", "domain", "deposit-nonce")
+}
+
+func init() {
+	BindQueryProposalFlags(queryProposalCmd)
+}
+
+func ValidateQueryProposalFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address: %s", Bridge)
+	}
+	return validateDataHash(DataHash)
+}
+
+func ProcessQueryProposalFlags(cmd *cobra.Command, args []string) {
+	BridgeAddr = common.HexToAddress(Bridge)
+	DataHashBytes = common.HexToHash(DataHash)
+}
+
+// ProposalResult is the query-proposal output
+type ProposalResult struct {
+	OriginDomainID uint8        `json:"originDomainId"`
+	DepositNonce   uint64       `json:"depositNonce"`
+	DataHash       common.Hash  `json:"dataHash"`
+	Status         string       `json:"status"`
+	YesVotes       uint8        `json:"yesVotes"`
+	YesVotesBitmap *hexutil.Big `json:"yesVotesBitmap"`
+	Threshold      uint8        `json:"threshold"`
+	ProposedBlock  string       `json:"proposedBlock"`
+}
+
+func QueryProposalCmd(cmd *cobra.Command, args []string, contract *celoBridge.BridgeContract) error {
+	status, err := contract.GetProposal(DomainID, DepositNonce, DataHashBytes)
+	if err != nil {
+		return err
+	}
+	threshold, err := contract.GetThreshold()
+	if err != nil {
+		return err
+	}
+	result := ProposalResult{
+		OriginDomainID: DomainID,
+		DepositNonce:   DepositNonce,
+		DataHash:       DataHashBytes,
+		Status:         message.StatusMap[status.Status],
+		YesVotes:       status.YesVotesTotal,
+		YesVotesBitmap: (*hexutil.Big)(status.YesVotes),
+		Threshold:      threshold,
+		ProposedBlock:  status.ProposedBlock.String(),
+	}
+
+	if JSON {
+		return printJSON(result)
+	}
+	fmt.Printf(`
+Proposal %d-%d
+Data hash: %s
+Status: %s
+Yes votes: %d/%d
+Proposed block: %s
+`, result.OriginDomainID, result.DepositNonce, result.DataHash.Hex(), result.Status, result.YesVotes, result.Threshold, result.ProposedBlock)
+	return nil
+}
+
+func printJSON(v interface{}) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
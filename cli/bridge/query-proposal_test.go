@@ -0,0 +1,53 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+const testDataHash = "0x6bbd16d5bb7e84ea1ac8e2d59c4c5f52e0eed7a9a3b9a5bd6d7d1d97e6c5b07e"
+
+type ProposalFlagsTestSuite struct {
+	suite.Suite
+}
+
+func TestRunProposalFlagsTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalFlagsTestSuite))
+}
+
+func (s *ProposalFlagsTestSuite) SetupTest() {
+	Bridge, DataHash = "0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B", testDataHash
+}
+
+func (s *ProposalFlagsTestSuite) TestValidateQueryProposalFlags() {
+	s.Nil(ValidateQueryProposalFlags(nil, nil))
+
+	DataHash = "0x1234"
+	s.NotNil(ValidateQueryProposalFlags(nil, nil))
+
+	Bridge, DataHash = "bridge", testDataHash
+	s.NotNil(ValidateQueryProposalFlags(nil, nil))
+}
+
+func (s *ProposalFlagsTestSuite) TestValidateCancelProposalFlags() {
+	s.Nil(ValidateCancelProposalFlags(nil, nil))
+
+	DataHash = ""
+	s.NotNil(ValidateCancelProposalFlags(nil, nil))
+
+	Bridge, DataHash = "0x1234", testDataHash
+	s.NotNil(ValidateCancelProposalFlags(nil, nil))
+}
+
+func (s *ProposalFlagsTestSuite) TestProcessProposalFlags() {
+	ProcessQueryProposalFlags(nil, nil)
+	s.Equal(common.HexToAddress(Bridge), BridgeAddr)
+	s.Equal(common.HexToHash(testDataHash), DataHashBytes)
+
+	BridgeAddr, DataHashBytes = common.Address{}, common.Hash{}
+	ProcessCancelProposalFlags(nil, nil)
+	s.Equal(common.HexToAddress(Bridge), BridgeAddr)
+	s.Equal(common.HexToHash(testDataHash), DataHashBytes)
+}
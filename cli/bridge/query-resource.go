@@ -0,0 +1,102 @@
+package bridge
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/handler"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	bridgeContract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+)
+
+var queryResourceCmd = &cobra.Command{
+	Use:   "query-resource",
+	Short: "Query the handler and contract of a resource ID",
+	Long:  "The query-resource subcommand queries the handler a resource ID is registered with on the bridge and the contract the handler maps it to",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return QueryResourceCmd(cmd, args, c, bridgeContract.NewBridgeContract(c, BridgeAddr, nil))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateQueryResourceFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessQueryResourceFlags(cmd, args)
+	},
+}
+
+func BindQueryResourceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	cmd.Flags().StringVar(&ResourceID, "resource", "", "Resource ID to query")
+	cmd.Flags().BoolVar(&JSON, "json", false, "Print the result as JSON")
+	flags.MarkFlagsAsRequired(cmd, "bridge", "resource")
+}
+
+func init() {
+	BindQueryResourceFlags(queryResourceCmd)
+}
+
+func ValidateQueryResourceFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address: %s", Bridge)
+	}
+	return nil
+}
+
+func ProcessQueryResourceFlags(cmd *cobra.Command, args []string) error {
+	var err error
+	BridgeAddr = common.HexToAddress(Bridge)
+	ResourceIdBytesArr, err = flags.ProcessResourceID(ResourceID)
+	return err
+}
+
+// ResourceResult is the query-resource output
+type ResourceResult struct {
+	ResourceID string          `json:"resourceId"`
+	Handler    common.Address  `json:"handler"`
+	Contract   *common.Address `json:"contract"`
+}
+
+func QueryResourceCmd(cmd *cobra.Command, args []string, client calls.ContractCallerDispatcher, contract *bridgeContract.BridgeContract) error {
+	handlerAddr, err := contract.GetHandlerAddressForResourceID(ResourceIdBytesArr)
+	if err != nil {
+		return err
+	}
+	result := ResourceResult{
+		ResourceID: hexutil.Encode(ResourceIdBytesArr[:]),
+		Handler:    handlerAddr,
+	}
+	if handlerAddr != (common.Address{}) {
+		contractAddr, err := handler.NewHandlerContract(client, handlerAddr).ResourceContractAddress(ResourceIdBytesArr)
+		if err != nil {
+			return err
+		}
+		result.Contract = &contractAddr
+	}
+
+	if JSON {
+		return printJSON(result)
+	}
+	if result.Contract == nil {
+		fmt.Printf("\nResource %s is not registered\n", result.ResourceID)
+		return nil
+	}
+	fmt.Printf(`
+Resource ID: %s
+Handler: %s
+Contract: %s
+`, result.ResourceID, result.Handler.Hex(), result.Contract.Hex())
+	return nil
+}
@@ -0,0 +1,103 @@
+package bridge
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/consts"
+	"github.com/ChainSafe/chainbridge-celo-module/internal/calltest"
+	coreConsts "github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	bridgeContract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+// captureStdout returns what f prints to stdout
+func captureStdout(f func() error) (string, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return "", err
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	runErr := f()
+	os.Stdout = stdout
+	w.Close()
+	out, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return string(out), runErr
+}
+
+type QueryResourceTestSuite struct {
+	suite.Suite
+	caller   *calltest.ContractCaller
+	bridge   common.Address
+	handler  common.Address
+	contract common.Address
+}
+
+func TestRunQueryResourceTestSuite(t *testing.T) {
+	suite.Run(t, new(QueryResourceTestSuite))
+}
+
+func (s *QueryResourceTestSuite) SetupTest() {
+	s.bridge = common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+	s.handler = common.HexToAddress("0x3167776db165D8eA0f51790CA2bbf44Db5105ADF")
+	s.contract = common.HexToAddress("0xF194afDf50B03e69Bd7D057c1Aa9e10c9954E4C9")
+	s.caller = calltest.NewContractCaller()
+	Bridge, ResourceID, JSON = s.bridge.Hex(), "0x0000000000000000000000000000000000000000000000000000000000000001", false
+	s.Require().Nil(ProcessQueryResourceFlags(nil, nil))
+}
+
+func (s *QueryResourceTestSuite) query() (string, error) {
+	return captureStdout(func() error {
+		return QueryResourceCmd(nil, nil, s.caller, bridgeContract.NewBridgeContract(s.caller, s.bridge, nil))
+	})
+}
+
+func (s *QueryResourceTestSuite) TestValidateQueryResourceFlags() {
+	s.Nil(ValidateQueryResourceFlags(nil, nil))
+
+	Bridge = "0x1234"
+	s.NotNil(ValidateQueryResourceFlags(nil, nil))
+}
+
+func (s *QueryResourceTestSuite) TestProcessQueryResourceFlagsFailsOnInvalidResourceID() {
+	ResourceID = "0xzz"
+	s.NotNil(ProcessQueryResourceFlags(nil, nil))
+}
+
+func (s *QueryResourceTestSuite) TestPrintsRegisteredResource() {
+	s.caller.Respond(s.bridge, coreConsts.BridgeABI, "_resourceIDToHandlerAddress", s.handler)
+	s.caller.Respond(s.handler, consts.HandlerABI, "_resourceIDToTokenContractAddress", s.contract)
+
+	out, err := s.query()
+	s.Nil(err)
+	s.Contains(out, "Handler: "+s.handler.Hex())
+	s.Contains(out, "Contract: "+s.contract.Hex())
+}
+
+func (s *QueryResourceTestSuite) TestPrintsUnregisteredResource() {
+	s.caller.Respond(s.bridge, coreConsts.BridgeABI, "_resourceIDToHandlerAddress", common.Address{})
+
+	out, err := s.query()
+	s.Nil(err)
+	s.Equal("\nResource 0x0000000000000000000000000000000000000000000000000000000000000001 is not registered\n", out)
+
+	JSON = true
+	out, err = s.query()
+	s.Nil(err)
+	s.JSONEq(`{
+		"resourceId": "0x0000000000000000000000000000000000000000000000000000000000000001",
+		"handler": "0x0000000000000000000000000000000000000000",
+		"contract": null
+	}`, out)
+}
+
+func (s *QueryResourceTestSuite) TestFailsIfBridgeCallFails() {
+	_, err := s.query()
+	s.NotNil(err)
+}
@@ -0,0 +1,401 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoClient "github.com/ChainSafe/chainbridge-celo-module/client"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+)
+
+// defaultGraceBlocks is the number of latest source blocks whose deposits are
+// pending rather than missing or stuck, about eight minutes of Celo blocks
+const defaultGraceBlocks = 100
+
+// Statuses of a reconciled transfer
+const (
+	ReconcileStatusExecuted  = "executed"
+	ReconcileStatusCancelled = "cancelled"
+	ReconcileStatusStuck     = "stuck"
+	ReconcileStatusMissing   = "missing"
+	ReconcileStatusPending   = "pending"
+)
+
+var reconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Reconcile deposits with their execution on the destination chain",
+	Long:  "The reconcile subcommand matches the Deposit events of the source bridge in a block range with the ProposalEvent events of the destination bridge by domain and deposit nonce, and reports the transfers that are missing, stuck or cancelled on the destination chain",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		source, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		destination, err := initialize.InitializeClient(DestURL, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return ReconcileCmd(cmd, args, source, destination)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateReconcileFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessReconcileFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindReconcileFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Source bridge contract address")
+	cmd.Flags().Uint64Var(&FromBlock, "from-block", 0, "First source block of the range")
+	cmd.Flags().Uint64Var(&ToBlock, "to-block", 0, "Last source block of the range, the latest block if not set")
+	cmd.Flags().StringVar(&DestURL, "destination-url", "", "URL of the destination chain node")
+	cmd.Flags().StringVar(&DestBridge, "destination-bridge", "", "Destination bridge contract address")
+	cmd.Flags().Uint64Var(&DestFromBlock, "destination-from-block", 0, "First destination block proposal events are searched from, the block before the first deposit if not set")
+	cmd.Flags().Uint64Var(&DestToBlock, "destination-to-block", 0, "Last destination block proposal events are searched in, the latest block if not set")
+	cmd.Flags().Uint64Var(&GraceBlocks, "grace-blocks", defaultGraceBlocks, "Number of latest source blocks whose unexecuted deposits are pending rather than missing or stuck")
+	cmd.Flags().Uint64Var(&Chunk, "chunk", celoClient.DefaultLogsChunk, "Maximum number of blocks queried per request")
+	cmd.Flags().BoolVar(&JSON, "json", false, "Print the report as JSON")
+	flags.MarkFlagsAsRequired(cmd, "bridge", "destination-url", "destination-bridge")
+}
+
+func init() {
+	BindReconcileFlags(reconcileCmd)
+}
+
+func ValidateReconcileFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address: %s", Bridge)
+	}
+	if !common.IsHexAddress(DestBridge) {
+		return fmt.Errorf("invalid destination bridge address: %s", DestBridge)
+	}
+	if cmd.Flags().Changed("to-block") && ToBlock < FromBlock {
+		return errors.New("to-block should not be lower than from-block")
+	}
+	if cmd.Flags().Changed("destination-to-block") && DestToBlock < DestFromBlock {
+		return errors.New("destination-to-block should not be lower than destination-from-block")
+	}
+	return nil
+}
+
+func ProcessReconcileFlags(cmd *cobra.Command, args []string) {
+	BridgeAddr = common.HexToAddress(Bridge)
+	DestBridgeAddr = common.HexToAddress(DestBridge)
+}
+
+// ReconciledTransfer is a deposit together with the outcome of its proposal on
+// the destination bridge
+type ReconciledTransfer struct {
+	DepositNonce uint64         `json:"depositNonce"`
+	DepositTx    common.Hash    `json:"depositTx"`
+	DepositBlock uint64         `json:"depositBlock"`
+	ResourceID   hexutil.Bytes  `json:"resourceId"`
+	Depositor    common.Address `json:"depositor"`
+	Kind         string         `json:"kind"`
+	Amount       *hexutil.Big   `json:"amount,omitempty"`
+	TokenID      *hexutil.Big   `json:"tokenId,omitempty"`
+	Recipient    hexutil.Bytes  `json:"recipient,omitempty"`
+	Status       string         `json:"status"`
+	// ProposalStatus is the status of the last ProposalEvent of the deposit
+	ProposalStatus string       `json:"proposalStatus,omitempty"`
+	ProposalTx     *common.Hash `json:"proposalTx,omitempty"`
+}
+
+// ResourceTotal is the number and the summed fungible amount of the transfers of
+// a resource with a status
+type ResourceTotal struct {
+	ResourceID hexutil.Bytes `json:"resourceId"`
+	Status     string        `json:"status"`
+	Count      int           `json:"count"`
+	Amount     *hexutil.Big  `json:"amount"`
+}
+
+// ReconcileReport is the reconcile output. Transfers only lists the deposits
+// that are not executed.
+type ReconcileReport struct {
+	SourceBridge         common.Address       `json:"sourceBridge"`
+	SourceDomainID       uint8                `json:"sourceDomainId"`
+	FromBlock            uint64               `json:"fromBlock"`
+	ToBlock              uint64               `json:"toBlock"`
+	DestinationBridge    common.Address       `json:"destinationBridge"`
+	DestinationDomainID  uint8                `json:"destinationDomainId"`
+	DestinationFromBlock uint64               `json:"destinationFromBlock"`
+	DestinationToBlock   uint64               `json:"destinationToBlock"`
+	Deposits             int                  `json:"deposits"`
+	Counts               map[string]int       `json:"counts"`
+	Totals               []ResourceTotal      `json:"totals"`
+	Transfers            []ReconciledTransfer `json:"transfers"`
+}
+
+func ReconcileCmd(cmd *cobra.Command, args []string, source, destination *evmclient.EVMClient) error {
+	ctx := context.Background()
+	sourceDomainID, err := celoBridge.NewBridgeContract(source, BridgeAddr, nil).DomainID()
+	if err != nil {
+		return err
+	}
+	destDomainID, err := celoBridge.NewBridgeContract(destination, DestBridgeAddr, nil).DomainID()
+	if err != nil {
+		return err
+	}
+
+	sourceLatest, err := source.LatestBlock()
+	if err != nil {
+		return err
+	}
+	to := sourceLatest.Uint64()
+	if cmd.Flags().Changed("to-block") {
+		to = ToBlock
+	}
+	destLatest, err := destination.LatestBlock()
+	if err != nil {
+		return err
+	}
+	destTo := destLatest.Uint64()
+	if cmd.Flags().Changed("destination-to-block") {
+		destTo = DestToBlock
+	}
+
+	deposits, err := findDeposits(ctx, source, destDomainID, FromBlock, to)
+	if err != nil {
+		return err
+	}
+	destFrom := DestFromBlock
+	if !cmd.Flags().Changed("destination-from-block") {
+		destFrom, err = defaultDestFromBlock(ctx, source, destination, deposits, destTo)
+		if err != nil {
+			return err
+		}
+	}
+	proposals, err := findLastProposalEvents(ctx, destination, sourceDomainID, destFrom, destTo)
+	if err != nil {
+		return err
+	}
+
+	report := ReconcileReport{
+		SourceBridge:         BridgeAddr,
+		SourceDomainID:       sourceDomainID,
+		FromBlock:            FromBlock,
+		ToBlock:              to,
+		DestinationBridge:    DestBridgeAddr,
+		DestinationDomainID:  destDomainID,
+		DestinationFromBlock: destFrom,
+		DestinationToBlock:   destTo,
+		Deposits:             len(deposits),
+		Counts:               map[string]int{},
+		Totals:               []ResourceTotal{},
+		Transfers:            []ReconciledTransfer{},
+	}
+	totals := map[string]*ResourceTotal{}
+	for _, d := range deposits {
+		t := reconcileTransfer(d, proposals[d.DepositNonce], sourceLatest.Uint64())
+		report.Counts[t.Status]++
+
+		key := t.ResourceID.String() + t.Status
+		total, ok := totals[key]
+		if !ok {
+			total = &ResourceTotal{ResourceID: t.ResourceID, Status: t.Status, Amount: (*hexutil.Big)(big.NewInt(0))}
+			totals[key] = total
+		}
+		total.Count++
+		if t.Amount != nil {
+			total.Amount.ToInt().Add(total.Amount.ToInt(), t.Amount.ToInt())
+		}
+		if t.Status != ReconcileStatusExecuted {
+			report.Transfers = append(report.Transfers, t)
+		}
+	}
+	keys := make([]string, 0, len(totals))
+	for key := range totals {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		report.Totals = append(report.Totals, *totals[key])
+	}
+
+	if JSON {
+		return printJSON(report)
+	}
+	printReconcileReport(&report)
+	return nil
+}
+
+// defaultDestFromBlock returns the last destination block mined before the first
+// of deposits, so that no proposal of them is missed, or destTo if there are no deposits
+func defaultDestFromBlock(ctx context.Context, source, destination celoClient.RPCCaller, deposits []*celoBridge.DepositEvent, destTo uint64) (uint64, error) {
+	if len(deposits) == 0 {
+		return destTo, nil
+	}
+	depositTime, err := celoClient.BlockTime(ctx, source, deposits[0].BlockNumber)
+	if err != nil {
+		return 0, err
+	}
+	return celoClient.FindBlockByTime(ctx, destination, depositTime, destTo)
+}
+
+// findDeposits returns the Deposit events of the source bridge to destDomainID
+// between from and to
+func findDeposits(ctx context.Context, source *evmclient.EVMClient, destDomainID uint8, from, to uint64) ([]*celoBridge.DepositEvent, error) {
+	q := ethereum.FilterQuery{
+		Addresses: []common.Address{BridgeAddr},
+		Topics:    [][]common.Hash{{util.Deposit.GetTopic()}},
+	}
+	var deposits []*celoBridge.DepositEvent
+	err := celoClient.FilterLogsInChunks(ctx, source, q, from, to, Chunk, func(logs []types.Log) error {
+		for _, l := range logs {
+			d, err := celoBridge.UnpackDepositEvent(l)
+			if err != nil {
+				return err
+			}
+			if d.DestinationDomainID == destDomainID {
+				deposits = append(deposits, d)
+			}
+		}
+		return nil
+	})
+	return deposits, err
+}
+
+// findLastProposalEvents returns the last ProposalEvent of each deposit nonce
+// from sourceDomainID on the destination bridge between from and to
+func findLastProposalEvents(ctx context.Context, destination *evmclient.EVMClient, sourceDomainID uint8, from, to uint64) (map[uint64]*celoBridge.ProposalEvent, error) {
+	q := ethereum.FilterQuery{
+		Addresses: []common.Address{DestBridgeAddr},
+		Topics:    [][]common.Hash{{util.ProposalEvent.GetTopic()}},
+	}
+	proposals := map[uint64]*celoBridge.ProposalEvent{}
+	err := celoClient.FilterLogsInChunks(ctx, destination, q, from, to, Chunk, func(logs []types.Log) error {
+		for _, l := range logs {
+			e, err := celoBridge.UnpackProposalEvent(l)
+			if err != nil {
+				return err
+			}
+			// logs are returned in order, so later events replace earlier ones
+			if e.OriginDomainID == sourceDomainID {
+				proposals[e.DepositNonce] = e
+			}
+		}
+		return nil
+	})
+	return proposals, err
+}
+
+// reconcileTransfer determines the status of deposit d from the last proposal
+// event p of its nonce, which is nil if there is none
+func reconcileTransfer(d *celoBridge.DepositEvent, p *celoBridge.ProposalEvent, sourceLatest uint64) ReconciledTransfer {
+	t := ReconciledTransfer{
+		DepositNonce: d.DepositNonce,
+		DepositTx:    d.TxHash,
+		DepositBlock: d.BlockNumber,
+		ResourceID:   d.ResourceID[:],
+		Depositor:    d.User,
+	}
+	data := celoBridge.DecodeDepositData(d.Data)
+	t.Kind = data.Kind
+	switch data.Kind {
+	case celoBridge.DepositKindFungible:
+		t.Amount = (*hexutil.Big)(data.Amount)
+		t.Recipient = data.Recipient
+	case celoBridge.DepositKindNonFungible:
+		t.TokenID = (*hexutil.Big)(data.Amount)
+		t.Recipient = data.Recipient
+	}
+
+	inGrace := sourceLatest < d.BlockNumber+GraceBlocks
+	switch {
+	case p == nil && inGrace:
+		t.Status = ReconcileStatusPending
+	case p == nil:
+		t.Status = ReconcileStatusMissing
+	case p.Status == message.ProposalStatusExecuted:
+		t.Status = ReconcileStatusExecuted
+	case p.Status == message.ProposalStatusCanceled:
+		t.Status = ReconcileStatusCancelled
+	case inGrace:
+		t.Status = ReconcileStatusPending
+	default:
+		t.Status = ReconcileStatusStuck
+	}
+	if p != nil {
+		t.ProposalStatus = message.StatusMap[p.Status]
+		t.ProposalTx = &p.TxHash
+	}
+	return t
+}
+
+func printReconcileReport(r *ReconcileReport) {
+	fmt.Printf(`
+Deposits from domain %d (bridge %s, blocks %d-%d)
+to domain %d (bridge %s, blocks %d-%d)
+
+Deposits: %d
+Executed: %d
+Cancelled: %d
+Stuck: %d
+Missing: %d
+Pending: %d
+`,
+		r.SourceDomainID, r.SourceBridge.Hex(), r.FromBlock, r.ToBlock,
+		r.DestinationDomainID, r.DestinationBridge.Hex(), r.DestinationFromBlock, r.DestinationToBlock,
+		r.Deposits,
+		r.Counts[ReconcileStatusExecuted],
+		r.Counts[ReconcileStatusCancelled],
+		r.Counts[ReconcileStatusStuck],
+		r.Counts[ReconcileStatusMissing],
+		r.Counts[ReconcileStatusPending],
+	)
+
+	if len(r.Totals) > 0 {
+		fmt.Println("\nTotals:")
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "RESOURCE ID\tSTATUS\tCOUNT\tAMOUNT")
+		for _, t := range r.Totals {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", t.ResourceID, t.Status, t.Count, t.Amount.ToInt())
+		}
+		w.Flush()
+	}
+
+	if len(r.Transfers) == 0 {
+		fmt.Println("\nAll deposits were executed")
+		return
+	}
+	fmt.Println("\nNot executed:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NONCE\tSTATUS\tPROPOSAL\tRESOURCE ID\tAMOUNT\tDEPOSIT TX")
+	for _, t := range r.Transfers {
+		amount := "-"
+		switch {
+		case t.Amount != nil:
+			amount = t.Amount.ToInt().String()
+		case t.TokenID != nil:
+			amount = "token " + t.TokenID.ToInt().String()
+		}
+		proposal := t.ProposalStatus
+		if proposal == "" {
+			proposal = "-"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", t.DepositNonce, t.Status, proposal, t.ResourceID, amount, t.DepositTx.Hex())
+	}
+	w.Flush()
+}
@@ -0,0 +1,122 @@
+package bridge
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	callsUtil "github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	bridgeContract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var registerGenericResourceCmd = &cobra.Command{
+	Use:   "register-generic-resource",
+	Short: "Register a generic resource ID",
+	Long:  "The register-generic-resource subcommand registers a resource ID with a contract address and its deposit and execute functions on the generic handler",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return RegisterGenericResourceCmd(cmd, args, bridgeContract.NewBridgeContract(c, BridgeAddr, t))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateRegisterGenericResourceFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessRegisterGenericResourceFlags(cmd, args)
+	},
+}
+
+func BindRegisterGenericResourceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Handler, "handler", "", "Generic handler contract address")
+	cmd.Flags().StringVar(&ResourceID, "resource", "", "Resource ID to register")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address")
+	cmd.Flags().StringVar(&Target, "target", "", "Contract address or hash storage to be registered")
+	cmd.Flags().StringVar(&Deposit, "deposit", "0x00000000", "Deposit function selector")
+	cmd.Flags().StringVar(&Execute, "execute", "0x00000000", "Execute proposal function selector")
+	cmd.Flags().Uint64Var(&DepositerOffset, "depositer-offset", 0, "Offset of the depositer address in the deposit function call data")
+	cmd.Flags().BoolVar(&Hash, "hash", false, "Treat function inputs as function prototype strings, hash and take the first 4 bytes")
+	flags.MarkFlagsAsRequired(cmd, "handler", "resource", "bridge", "target")
+}
+
+func init() {
+	BindRegisterGenericResourceFlags(registerGenericResourceCmd)
+}
+
+func ValidateRegisterGenericResourceFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Handler) {
+		return fmt.Errorf("invalid handler address %s", Handler)
+	}
+	if !common.IsHexAddress(Target) {
+		return fmt.Errorf("invalid target address %s", Target)
+	}
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	if !Hash {
+		if len(common.FromHex(Deposit)) != 4 {
+			return fmt.Errorf("invalid deposit function selector %s", Deposit)
+		}
+		if len(common.FromHex(Execute)) != 4 {
+			return fmt.Errorf("invalid execute function selector %s", Execute)
+		}
+	}
+	return nil
+}
+
+func ProcessRegisterGenericResourceFlags(cmd *cobra.Command, args []string) error {
+	var err error
+	HandlerAddr = common.HexToAddress(Handler)
+	TargetContractAddr = common.HexToAddress(Target)
+	BridgeAddr = common.HexToAddress(Bridge)
+	ResourceIdBytesArr, err = flags.ProcessResourceID(ResourceID)
+	if err != nil {
+		return err
+	}
+
+	if Hash {
+		DepositSigBytes = callsUtil.GetSolidityFunctionSig([]byte(Deposit))
+		ExecuteSigBytes = callsUtil.GetSolidityFunctionSig([]byte(Execute))
+	} else {
+		copy(DepositSigBytes[:], common.FromHex(Deposit))
+		copy(ExecuteSigBytes[:], common.FromHex(Execute))
+	}
+	return nil
+}
+
+func RegisterGenericResourceCmd(cmd *cobra.Command, args []string, contract *bridgeContract.BridgeContract) error {
+	log.Info().Msgf("Registering contract %s with resource ID %s on handler %s", TargetContractAddr, ResourceID, HandlerAddr)
+
+	h, err := contract.AdminSetGenericResource(
+		HandlerAddr,
+		ResourceIdBytesArr,
+		TargetContractAddr,
+		DepositSigBytes,
+		new(big.Int).SetUint64(DepositerOffset),
+		ExecuteSigBytes,
+		transactor.TransactOptions{GasLimit: gasLimit},
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed registering generic resource")
+		return err
+	}
+
+	log.Info().Msgf("Generic resource registered with transaction: %s", h.Hex())
+	return nil
+}
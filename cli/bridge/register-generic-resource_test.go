@@ -0,0 +1,70 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type RegisterGenericResourceFlagsTestSuite struct {
+	suite.Suite
+}
+
+func TestRunRegisterGenericResourceFlagsTestSuite(t *testing.T) {
+	suite.Run(t, new(RegisterGenericResourceFlagsTestSuite))
+}
+
+func (s *RegisterGenericResourceFlagsTestSuite) SetupTest() {
+	Handler = "0x3167776db165D8eA0f51790CA2bbf44Db5105ADF"
+	Target = "0xF194afDf50B03e69Bd7D057c1Aa9e10c9954E4C9"
+	Bridge = "0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B"
+	ResourceID = "0x0000000000000000000000000000000000000000000000000000000000000001"
+	Deposit, Execute, Hash = "0x00000000", "0x12345678", false
+}
+
+func (s *RegisterGenericResourceFlagsTestSuite) TestValidateAddresses() {
+	s.Nil(ValidateRegisterGenericResourceFlags(nil, nil))
+
+	Handler = "handler"
+	s.NotNil(ValidateRegisterGenericResourceFlags(nil, nil))
+
+	s.SetupTest()
+	Target = "0x1234"
+	s.NotNil(ValidateRegisterGenericResourceFlags(nil, nil))
+
+	s.SetupTest()
+	Bridge = ""
+	s.NotNil(ValidateRegisterGenericResourceFlags(nil, nil))
+}
+
+func (s *RegisterGenericResourceFlagsTestSuite) TestValidateSelectors() {
+	Deposit = "0x1234"
+	s.NotNil(ValidateRegisterGenericResourceFlags(nil, nil))
+
+	Deposit, Execute = "0x00000000", "0x1234567890"
+	s.NotNil(ValidateRegisterGenericResourceFlags(nil, nil))
+
+	// function prototypes are only hashed with --hash
+	Deposit, Execute, Hash = "deposit(bytes)", "store(bytes32)", true
+	s.Nil(ValidateRegisterGenericResourceFlags(nil, nil))
+}
+
+func (s *RegisterGenericResourceFlagsTestSuite) TestProcessSelectors() {
+	s.Nil(ProcessRegisterGenericResourceFlags(nil, nil))
+	s.Equal(common.HexToAddress(Handler), HandlerAddr)
+	s.Equal(common.HexToAddress(Target), TargetContractAddr)
+	s.Equal([4]byte{}, DepositSigBytes)
+	s.Equal([4]byte{0x12, 0x34, 0x56, 0x78}, ExecuteSigBytes)
+
+	Deposit, Execute, Hash = "deposit(bytes)", "store(bytes32)", true
+	s.Nil(ProcessRegisterGenericResourceFlags(nil, nil))
+	s.Equal(calls.GetSolidityFunctionSig([]byte("deposit(bytes)")), DepositSigBytes)
+	s.Equal(calls.GetSolidityFunctionSig([]byte("store(bytes32)")), ExecuteSigBytes)
+}
+
+func (s *RegisterGenericResourceFlagsTestSuite) TestProcessFailsOnInvalidResourceID() {
+	ResourceID = "0xzz"
+	s.NotNil(ProcessRegisterGenericResourceFlags(nil, nil))
+}
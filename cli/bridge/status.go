@@ -0,0 +1,274 @@
+package bridge
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	celo "github.com/ChainSafe/chainbridge-celo-module"
+	celoCli "github.com/ChainSafe/chainbridge-celo-module/cli/celo"
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/handler"
+	callsUtil "github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ChainSafe/chainbridge-core/config"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the state of one or more bridges",
+	Long:  "The status subcommand shows the paused state, admins, relayers, threshold, fee, deposit counts and registered resources of the bridge given by --bridge or of every EVM bridge in a relayer configuration file",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return StatusCmd(cmd, args)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateStatusFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessStatusFlags(cmd, args)
+	},
+}
+
+func BindStatusFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&ConfigPath, "config", "", "Relayer configuration file, every EVM chain in it is shown")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Bridge contract address on the chain of --url, used instead of --config")
+	cmd.Flags().UintSliceVar(&Domains, "domains", nil, "Destination domain IDs deposit counts are shown for, the domains of all configured chains with --config")
+	cmd.Flags().StringSliceVar(&ResourceIDs, "resource-ids", nil, "Resource IDs shown in addition to the resourceIds and goldTokenResourceId of configured chains")
+	cmd.Flags().BoolVar(&JSON, "json", false, "Print the result as JSON")
+}
+
+func init() {
+	BindStatusFlags(statusCmd)
+}
+
+func ValidateStatusFlags(cmd *cobra.Command, args []string) error {
+	if (ConfigPath == "") == (Bridge == "") {
+		return errors.New("either config or bridge should be set")
+	}
+	if Bridge != "" && !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address: %s", Bridge)
+	}
+	for _, d := range Domains {
+		if d > 255 {
+			return fmt.Errorf("invalid domain ID: %d", d)
+		}
+	}
+	for _, r := range ResourceIDs {
+		if len(common.FromHex(r)) != 32 {
+			return fmt.Errorf("invalid resource ID: %s", r)
+		}
+	}
+	return nil
+}
+
+// statusTarget is a bridge shown by the status command
+type statusTarget struct {
+	name        string
+	url         string
+	bridge      common.Address
+	resourceIDs []string
+}
+
+func ProcessStatusFlags(cmd *cobra.Command, args []string) error {
+	StatusTargets = nil
+	StatusDomains = nil
+	for _, d := range Domains {
+		StatusDomains = append(StatusDomains, uint8(d))
+	}
+	if Bridge != "" {
+		StatusTargets = []statusTarget{{url: url, bridge: common.HexToAddress(Bridge), resourceIDs: ResourceIDs}}
+		return nil
+	}
+
+	cfg, err := config.GetConfig(ConfigPath)
+	if err != nil {
+		return err
+	}
+	for _, rawConfig := range cfg.ChainConfigs {
+		if rawConfig["type"] != "celo" && rawConfig["type"] != "evm" {
+			continue
+		}
+		chainConfig, err := celo.NewCeloConfig(rawConfig)
+		if err != nil {
+			return err
+		}
+		resourceIDs := append([]string{}, chainConfig.ResourceIDs...)
+		if chainConfig.GoldTokenResourceID != "" {
+			resourceIDs = append(resourceIDs, chainConfig.GoldTokenResourceID)
+		}
+		StatusTargets = append(StatusTargets, statusTarget{
+			name:        chainConfig.GeneralChainConfig.Name,
+			url:         chainConfig.GeneralChainConfig.Endpoint,
+			bridge:      common.HexToAddress(chainConfig.Bridge),
+			resourceIDs: append(resourceIDs, ResourceIDs...),
+		})
+		if len(Domains) == 0 {
+			StatusDomains = append(StatusDomains, *chainConfig.GeneralChainConfig.Id)
+		}
+	}
+	if len(StatusTargets) == 0 {
+		return errors.New("no EVM chains found in config")
+	}
+	return nil
+}
+
+// DepositCount is the number of deposits made to a destination domain
+type DepositCount struct {
+	DomainID uint8  `json:"domainId"`
+	Count    uint64 `json:"count"`
+}
+
+// BridgeStatus is the status output of a single bridge
+type BridgeStatus struct {
+	Name          string           `json:"name,omitempty"`
+	Bridge        common.Address   `json:"bridge"`
+	DomainID      uint8            `json:"domainId"`
+	Paused        bool             `json:"paused"`
+	Admins        []common.Address `json:"admins"`
+	Threshold     uint8            `json:"threshold"`
+	Relayers      []common.Address `json:"relayers"`
+	Fee           *hexutil.Big     `json:"fee"`
+	DepositCounts []DepositCount   `json:"depositCounts"`
+	Resources     []ResourceResult `json:"resources"`
+	Error         string           `json:"error,omitempty"`
+}
+
+func StatusCmd(cmd *cobra.Command, args []string) error {
+	statuses := make([]*BridgeStatus, 0, len(StatusTargets))
+	for _, target := range StatusTargets {
+		s, err := bridgeStatus(target)
+		if err != nil {
+			// an unreachable chain shouldn't hide the state of the others
+			s = &BridgeStatus{Name: target.name, Bridge: target.bridge, Error: err.Error()}
+		}
+		statuses = append(statuses, s)
+	}
+
+	if JSON {
+		return printJSON(statuses)
+	}
+	for _, s := range statuses {
+		printBridgeStatus(s)
+	}
+	return nil
+}
+
+func bridgeStatus(target statusTarget) (*BridgeStatus, error) {
+	c, err := initialize.InitializeClient(target.url, senderKeyPair)
+	if err != nil {
+		return nil, err
+	}
+	contract := celoBridge.NewBridgeContract(c, target.bridge, nil)
+	s := &BridgeStatus{Name: target.name, Bridge: target.bridge}
+	if s.DomainID, err = contract.DomainID(); err != nil {
+		return nil, err
+	}
+	if s.Paused, err = contract.IsPaused(); err != nil {
+		return nil, err
+	}
+	if s.Admins, err = contract.GetAdmins(); err != nil {
+		return nil, err
+	}
+	if s.Threshold, err = contract.GetThreshold(); err != nil {
+		return nil, err
+	}
+	if s.Relayers, err = contract.GetRelayers(); err != nil {
+		return nil, err
+	}
+	fee, err := contract.GetFee()
+	if err != nil {
+		return nil, err
+	}
+	s.Fee = (*hexutil.Big)(fee)
+
+	s.DepositCounts = []DepositCount{}
+	for _, domainID := range StatusDomains {
+		if domainID == s.DomainID {
+			continue
+		}
+		count, err := contract.GetDepositCount(domainID)
+		if err != nil {
+			return nil, err
+		}
+		s.DepositCounts = append(s.DepositCounts, DepositCount{DomainID: domainID, Count: count})
+	}
+
+	s.Resources = []ResourceResult{}
+	for _, r := range target.resourceIDs {
+		var resourceID types.ResourceID
+		copy(resourceID[:], common.FromHex(r))
+		result := ResourceResult{ResourceID: hexutil.Encode(resourceID[:])}
+		result.Handler, err = contract.GetHandlerAddressForResourceID(resourceID)
+		if err != nil {
+			return nil, err
+		}
+		if result.Handler != (common.Address{}) {
+			contractAddr, err := handler.NewHandlerContract(c, result.Handler).ResourceContractAddress(resourceID)
+			if err != nil {
+				return nil, err
+			}
+			result.Contract = &contractAddr
+		}
+		s.Resources = append(s.Resources, result)
+	}
+	return s, nil
+}
+
+func printBridgeStatus(s *BridgeStatus) {
+	title := fmt.Sprintf("Bridge %s", s.Bridge.Hex())
+	if s.Name != "" {
+		title = fmt.Sprintf("%s (%s)", title, s.Name)
+	}
+	fmt.Printf("\n%s\n", title)
+	if s.Error != "" {
+		fmt.Printf("Error: %s\n", s.Error)
+		return
+	}
+	fee, _ := callsUtil.WeiAmountToUser(s.Fee.ToInt(), big.NewInt(celoCli.CeloDecimals))
+	fmt.Printf(`Domain ID: %d
+Paused: %t
+Admins: %s
+Relayer threshold: %d of %d
+Relayers: %s
+Fee: %s (%s wei)
+`, s.DomainID, s.Paused, joinAddresses(s.Admins), s.Threshold, len(s.Relayers), joinAddresses(s.Relayers), fee.Text('f', -1), s.Fee.ToInt())
+	fmt.Println("Deposit counts:")
+	if len(s.DepositCounts) == 0 {
+		fmt.Println("  no destination domains given")
+	}
+	for _, d := range s.DepositCounts {
+		fmt.Printf("  domain %d: %d\n", d.DomainID, d.Count)
+	}
+	fmt.Println("Resources:")
+	if len(s.Resources) == 0 {
+		fmt.Println("  no resource IDs given")
+	}
+	for _, r := range s.Resources {
+		if r.Contract == nil {
+			fmt.Printf("  %s: not registered\n", r.ResourceID)
+			continue
+		}
+		fmt.Printf("  %s: handler %s, contract %s\n", r.ResourceID, r.Handler.Hex(), r.Contract.Hex())
+	}
+}
+
+func joinAddresses(addrs []common.Address) string {
+	if len(addrs) == 0 {
+		return "none"
+	}
+	s := make([]string, len(addrs))
+	for i, a := range addrs {
+		s[i] = a.Hex()
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,95 @@
+package bridge
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+const statusTestConfig = `{
+  "relayer": {},
+  "chains": [
+    {
+      "name": "alfajores",
+      "type": "celo",
+      "id": 1,
+      "endpoint": "https://alfajores-forno.celo-testnet.org",
+      "from": "0xff93B45308FD417dF303D6515aB04D9e89a750Ca",
+      "bridge": "0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B",
+      "resourceIds": ["0x0000000000000000000000000000000000000000000000000000000000000001"],
+      "goldTokenResourceId": "0x0000000000000000000000000000000000000000000000000000000000000100"
+    },
+    {
+      "name": "goerli",
+      "type": "evm",
+      "id": 2,
+      "endpoint": "https://goerli.example.org",
+      "from": "0xff93B45308FD417dF303D6515aB04D9e89a750Ca",
+      "bridge": "0x3167776db165D8eA0f51790CA2bbf44Db5105ADF"
+    },
+    {
+      "name": "substrate",
+      "type": "substrate",
+      "id": 3
+    }
+  ]
+}`
+
+type StatusFlagsTestSuite struct {
+	suite.Suite
+}
+
+func TestRunStatusFlagsTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusFlagsTestSuite))
+}
+
+func (s *StatusFlagsTestSuite) SetupTest() {
+	ConfigPath, Bridge, Domains, ResourceIDs = "", "", nil, nil
+}
+
+func (s *StatusFlagsTestSuite) TestValidateStatusFlags() {
+	s.NotNil(ValidateStatusFlags(nil, nil))
+
+	ConfigPath, Bridge = "config.json", "0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B"
+	s.NotNil(ValidateStatusFlags(nil, nil))
+
+	ConfigPath, Bridge = "", "0x1234"
+	s.NotNil(ValidateStatusFlags(nil, nil))
+
+	Bridge = "0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B"
+	Domains = []uint{256}
+	s.NotNil(ValidateStatusFlags(nil, nil))
+
+	Domains = []uint{1}
+	ResourceIDs = []string{"0x01"}
+	s.NotNil(ValidateStatusFlags(nil, nil))
+
+	ResourceIDs = []string{"0x0000000000000000000000000000000000000000000000000000000000000001"}
+	s.Nil(ValidateStatusFlags(nil, nil))
+}
+
+func (s *StatusFlagsTestSuite) TestProcessesEVMChainsOfConfig() {
+	ConfigPath = filepath.Join(s.T().TempDir(), "config.json")
+	s.Nil(ioutil.WriteFile(ConfigPath, []byte(statusTestConfig), 0644))
+	extra := "0x0000000000000000000000000000000000000000000000000000000000000002"
+	ResourceIDs = []string{extra}
+
+	s.Nil(ProcessStatusFlags(nil, nil))
+	s.Equal([]uint8{1, 2}, StatusDomains)
+	s.Len(StatusTargets, 2)
+	s.Equal("alfajores", StatusTargets[0].name)
+	s.Equal(common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B"), StatusTargets[0].bridge)
+	s.Equal([]string{
+		"0x0000000000000000000000000000000000000000000000000000000000000001",
+		"0x0000000000000000000000000000000000000000000000000000000000000100",
+		extra,
+	}, StatusTargets[0].resourceIDs)
+	s.Equal([]string{extra}, StatusTargets[1].resourceIDs)
+
+	Domains = []uint{5}
+	s.Nil(ProcessStatusFlags(nil, nil))
+	s.Equal([]uint8{5}, StatusDomains)
+}
@@ -0,0 +1,285 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoClient "github.com/ChainSafe/chainbridge-celo-module/client"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+)
+
+// Statuses of a tracked deposit
+const (
+	TrackStatusPending   = "pending"
+	TrackStatusPassed    = "passed"
+	TrackStatusExecuted  = "executed"
+	TrackStatusCancelled = "cancelled"
+	// TrackStatusNotFound is reported if no proposal event of the deposit is
+	// found in the searched destination blocks
+	TrackStatusNotFound = "not found"
+)
+
+var trackCmd = &cobra.Command{
+	Use:   "track",
+	Short: "Track a deposit to its destination chain",
+	Long:  "The track subcommand finds the Deposit event of a source transaction and follows the proposal it created on the destination bridge, reporting its status, votes and the transactions involved",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		source, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		destination, err := initialize.InitializeClient(DestURL, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return TrackCmd(cmd, args, source, destination)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateTrackFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessTrackFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindTrackFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&SourceTx, "source-tx", "", "Hash of the deposit transaction on the source chain")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Source bridge contract address, any bridge if not set")
+	cmd.Flags().StringVar(&DestURL, "destination-url", "", "URL of the destination chain node")
+	cmd.Flags().StringVar(&DestBridge, "destination-bridge", "", "Destination bridge contract address")
+	cmd.Flags().Uint64Var(&DestFromBlock, "destination-from-block", 0, "Destination block proposal events are searched from, the last block mined before the deposit if not set")
+	cmd.Flags().BoolVar(&JSON, "json", false, "Print the result as JSON")
+	flags.MarkFlagsAsRequired(cmd, "source-tx", "destination-url", "destination-bridge")
+}
+
+func init() {
+	BindTrackFlags(trackCmd)
+}
+
+func ValidateTrackFlags(cmd *cobra.Command, args []string) error {
+	if len(common.FromHex(SourceTx)) != common.HashLength {
+		return fmt.Errorf("invalid source transaction hash: %s", SourceTx)
+	}
+	if Bridge != "" && !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address: %s", Bridge)
+	}
+	if !common.IsHexAddress(DestBridge) {
+		return fmt.Errorf("invalid destination bridge address: %s", DestBridge)
+	}
+	return nil
+}
+
+func ProcessTrackFlags(cmd *cobra.Command, args []string) {
+	SourceTxHash = common.HexToHash(SourceTx)
+	BridgeAddr = common.Address{}
+	if Bridge != "" {
+		BridgeAddr = common.HexToAddress(Bridge)
+	}
+	DestBridgeAddr = common.HexToAddress(DestBridge)
+}
+
+// TrackedTx is a destination transaction that voted on or changed the status of a proposal
+type TrackedTx struct {
+	Hash        common.Hash `json:"hash"`
+	BlockNumber uint64      `json:"blockNumber"`
+	Event       string      `json:"event"`
+	Status      string      `json:"status"`
+}
+
+// TrackResult is the track output. Proposal events are searched for in the destination
+// blocks from DestinationFromBlock to DestinationToBlock.
+type TrackResult struct {
+	SourceTx             common.Hash    `json:"sourceTx"`
+	SourceBridge         common.Address `json:"sourceBridge"`
+	SourceDomainID       uint8          `json:"sourceDomainId"`
+	DestinationDomainID  uint8          `json:"destinationDomainId"`
+	DepositNonce         uint64         `json:"depositNonce"`
+	ResourceID           hexutil.Bytes  `json:"resourceId"`
+	Depositor            common.Address `json:"depositor"`
+	Status               string         `json:"status"`
+	DestinationFromBlock uint64         `json:"destinationFromBlock"`
+	DestinationToBlock   uint64         `json:"destinationToBlock"`
+	DataHash             *common.Hash   `json:"dataHash,omitempty"`
+	YesVotes             uint8          `json:"yesVotes"`
+	Threshold            uint8          `json:"threshold"`
+	Transactions         []TrackedTx    `json:"transactions"`
+}
+
+func TrackCmd(cmd *cobra.Command, args []string, source, destination *evmclient.EVMClient) error {
+	ctx := context.Background()
+	receipt, err := source.TransactionReceipt(ctx, SourceTxHash)
+	if err != nil {
+		return fmt.Errorf("failed getting source transaction receipt: %w", err)
+	}
+	deposit, sourceBridgeAddr, err := findDeposit(receipt, BridgeAddr)
+	if err != nil {
+		return err
+	}
+	sourceDomainID, err := celoBridge.NewBridgeContract(source, sourceBridgeAddr, nil).DomainID()
+	if err != nil {
+		return err
+	}
+
+	destBridge := celoBridge.NewBridgeContract(destination, DestBridgeAddr, nil)
+	destDomainID, err := destBridge.DomainID()
+	if err != nil {
+		return err
+	}
+	if destDomainID != deposit.DestinationDomainID {
+		return fmt.Errorf("deposit is destined for domain %d, destination bridge is on domain %d", deposit.DestinationDomainID, destDomainID)
+	}
+	latest, err := destination.LatestBlock()
+	if err != nil {
+		return err
+	}
+	from := DestFromBlock
+	if !cmd.Flags().Changed("destination-from-block") {
+		from, err = defaultDestFromBlock(ctx, source, destination, []*celoBridge.DepositEvent{deposit}, latest.Uint64())
+		if err != nil {
+			return err
+		}
+	}
+	events, err := findProposalEvents(ctx, destination, sourceDomainID, deposit.DepositNonce, from, latest.Uint64())
+	if err != nil {
+		return err
+	}
+	threshold, err := destBridge.GetThreshold()
+	if err != nil {
+		return err
+	}
+
+	result := TrackResult{
+		SourceTx:             SourceTxHash,
+		SourceBridge:         sourceBridgeAddr,
+		SourceDomainID:       sourceDomainID,
+		DestinationDomainID:  deposit.DestinationDomainID,
+		DepositNonce:         deposit.DepositNonce,
+		ResourceID:           deposit.ResourceID[:],
+		Depositor:            deposit.User,
+		Status:               TrackStatusNotFound,
+		DestinationFromBlock: from,
+		DestinationToBlock:   latest.Uint64(),
+		Threshold:            threshold,
+		Transactions:         []TrackedTx{},
+	}
+	for _, e := range events {
+		eventName := "ProposalEvent"
+		if e.Vote {
+			eventName = "ProposalVote"
+		}
+		result.Transactions = append(result.Transactions, TrackedTx{
+			Hash:        e.TxHash,
+			BlockNumber: e.BlockNumber,
+			Event:       eventName,
+			Status:      message.StatusMap[e.Status],
+		})
+	}
+	if len(events) > 0 {
+		dataHash := events[len(events)-1].DataHash
+		status, err := destBridge.GetProposal(sourceDomainID, deposit.DepositNonce, dataHash)
+		if err != nil {
+			return err
+		}
+		result.DataHash = &dataHash
+		result.YesVotes = status.YesVotesTotal
+		result.Status = trackStatus(status.Status)
+	}
+
+	if JSON {
+		return printJSON(result)
+	}
+	printTrackResult(&result)
+	return nil
+}
+
+// findDeposit returns the first Deposit event in receipt emitted by bridgeAddr, or by any
+// bridge if bridgeAddr is the zero address, together with the address of the bridge
+func findDeposit(receipt *types.Receipt, bridgeAddr common.Address) (*celoBridge.DepositEvent, common.Address, error) {
+	for _, l := range receipt.Logs {
+		if bridgeAddr != (common.Address{}) && l.Address != bridgeAddr {
+			continue
+		}
+		deposit, err := celoBridge.UnpackDepositEvent(*l)
+		if err != nil {
+			continue
+		}
+		return deposit, l.Address, nil
+	}
+	return nil, common.Address{}, errors.New("no Deposit event found in source transaction")
+}
+
+// findProposalEvents returns the ProposalEvent and ProposalVote events of the proposal
+// for the deposit with depositNonce from sourceDomainID on the destination bridge
+// between from and to
+func findProposalEvents(ctx context.Context, destination ethereum.LogFilterer, sourceDomainID uint8, depositNonce, from, to uint64) ([]*celoBridge.ProposalEvent, error) {
+	q := ethereum.FilterQuery{
+		Addresses: []common.Address{DestBridgeAddr},
+		Topics:    [][]common.Hash{{util.ProposalEvent.GetTopic(), util.ProposalVote.GetTopic()}},
+	}
+	var events []*celoBridge.ProposalEvent
+	err := celoClient.FilterLogsInChunks(ctx, destination, q, from, to, celoClient.DefaultLogsChunk, func(logs []types.Log) error {
+		for _, l := range logs {
+			e, err := celoBridge.UnpackProposalEvent(l)
+			if err != nil {
+				continue
+			}
+			if e.OriginDomainID == sourceDomainID && e.DepositNonce == depositNonce {
+				events = append(events, e)
+			}
+		}
+		return nil
+	})
+	return events, err
+}
+
+func trackStatus(proposalStatus uint8) string {
+	switch proposalStatus {
+	case message.ProposalStatusPassed:
+		return TrackStatusPassed
+	case message.ProposalStatusExecuted:
+		return TrackStatusExecuted
+	case message.ProposalStatusCanceled:
+		return TrackStatusCancelled
+	default:
+		return TrackStatusPending
+	}
+}
+
+func printTrackResult(r *TrackResult) {
+	fmt.Printf(`
+Deposit %d-%d to domain %d
+Source tx: %s
+Resource ID: %s
+Depositor: %s
+Status: %s
+Yes votes: %d/%d
+`, r.SourceDomainID, r.DepositNonce, r.DestinationDomainID, r.SourceTx.Hex(), r.ResourceID, r.Depositor.Hex(), r.Status, r.YesVotes, r.Threshold)
+	if r.DataHash != nil {
+		fmt.Printf("Data hash: %s\n", r.DataHash.Hex())
+	}
+	if len(r.Transactions) == 0 {
+		fmt.Printf("No proposal found in destination blocks %d-%d\n", r.DestinationFromBlock, r.DestinationToBlock)
+		return
+	}
+	fmt.Println("Destination transactions:")
+	for _, tx := range r.Transactions {
+		fmt.Printf("  %s %s (%s) in block %d\n", tx.Hash.Hex(), tx.Event, tx.Status, tx.BlockNumber)
+	}
+}
@@ -0,0 +1,179 @@
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeChain answers eth_getBlockByNumber with blocks timed by times, indexed by
+// block number, and returns the logs matching the addresses, topics and block
+// range of log queries
+type fakeChain struct {
+	times []uint64
+	logs  []types.Log
+}
+
+func (c *fakeChain) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if method != "eth_getBlockByNumber" {
+		return errors.New("method not found")
+	}
+	number, err := hexutil.DecodeUint64(args[0].(string))
+	if err != nil {
+		return err
+	}
+	raw := []byte("null")
+	if number < uint64(len(c.times)) {
+		raw, _ = json.Marshal(map[string]interface{}{"timestamp": hexutil.Uint64(c.times[number])})
+	}
+	return json.Unmarshal(raw, result)
+}
+
+func (c *fakeChain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	for _, l := range c.logs {
+		if l.BlockNumber < q.FromBlock.Uint64() || l.BlockNumber > q.ToBlock.Uint64() {
+			continue
+		}
+		if len(q.Addresses) > 0 && !containsAddress(q.Addresses, l.Address) {
+			continue
+		}
+		if !matchesTopics(q.Topics, l.Topics) {
+			continue
+		}
+		logs = append(logs, l)
+	}
+	return logs, nil
+}
+
+func (c *fakeChain) LatestBlock() (*big.Int, error) {
+	return big.NewInt(int64(len(c.times) - 1)), nil
+}
+
+func matchesTopics(filter [][]common.Hash, topics []common.Hash) bool {
+	for i, hashes := range filter {
+		if len(hashes) == 0 {
+			continue
+		}
+		if i >= len(topics) || !containsHash(hashes, topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *fakeChain) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func containsAddress(addresses []common.Address, a common.Address) bool {
+	for _, x := range addresses {
+		if x == a {
+			return true
+		}
+	}
+	return false
+}
+
+func containsHash(hashes []common.Hash, h common.Hash) bool {
+	for _, x := range hashes {
+		if x == h {
+			return true
+		}
+	}
+	return false
+}
+
+// proposalLog returns a ProposalVote, or ProposalEvent if vote is false, log of bridge
+func proposalLog(bridge common.Address, block uint64, vote bool, origin uint8, nonce uint64, status uint8) types.Log {
+	bridgeABI, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	sig, name := util.ProposalEvent, "ProposalEvent"
+	if vote {
+		sig, name = util.ProposalVote, "ProposalVote"
+	}
+	data, err := bridgeABI.Events[name].Inputs.Pack(origin, nonce, status, common.HexToHash("0xd"))
+	if err != nil {
+		panic(err)
+	}
+	return types.Log{
+		Address:     bridge,
+		Topics:      []common.Hash{sig.GetTopic()},
+		Data:        data,
+		BlockNumber: block,
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(block)),
+	}
+}
+
+type TrackTestSuite struct {
+	suite.Suite
+	source      *fakeChain
+	destination *fakeChain
+}
+
+func TestRunTrackTestSuite(t *testing.T) {
+	suite.Run(t, new(TrackTestSuite))
+}
+
+func (s *TrackTestSuite) SetupTest() {
+	DestBridgeAddr = common.HexToAddress("0xde")
+	// source blocks every 5 seconds and destination blocks every 2 seconds from 1000
+	s.source = &fakeChain{}
+	s.destination = &fakeChain{}
+	for i := uint64(0); i < 50; i++ {
+		s.source.times = append(s.source.times, 1000+5*i)
+		s.destination.times = append(s.destination.times, 1000+2*i)
+	}
+}
+
+func (s *TrackTestSuite) TestSearchesFromLastBlockBeforeDeposit() {
+	// source block 10 is mined at 1050, after destination block 25
+	from, err := defaultDestFromBlock(context.Background(), s.source, s.destination, []*celoBridge.DepositEvent{{BlockNumber: 10}}, 49)
+	s.Nil(err)
+	s.Equal(uint64(25), from)
+}
+
+func (s *TrackTestSuite) TestFindsProposalEventsOfDeposit() {
+	s.destination.logs = []types.Log{
+		proposalLog(DestBridgeAddr, 20, false, 1, 7, 1),
+		proposalLog(DestBridgeAddr, 26, false, 1, 7, 1),
+		proposalLog(DestBridgeAddr, 27, true, 1, 8, 1),
+		proposalLog(DestBridgeAddr, 28, true, 2, 7, 1),
+		proposalLog(common.HexToAddress("0xee"), 29, true, 1, 7, 1),
+		proposalLog(DestBridgeAddr, 30, true, 1, 7, 2),
+		proposalLog(DestBridgeAddr, 31, false, 1, 7, 3),
+	}
+
+	events, err := findProposalEvents(context.Background(), s.destination, 1, 7, 25, 49)
+	s.Nil(err)
+	s.Len(events, 3)
+	s.Equal(uint64(26), events[0].BlockNumber)
+	s.True(events[1].Vote)
+	s.Equal(uint8(3), events[2].Status)
+}
+
+func (s *TrackTestSuite) TestReportsSearchedRangeIfNotFound() {
+	events, err := findProposalEvents(context.Background(), s.destination, 1, 7, 25, 49)
+	s.Nil(err)
+	s.Empty(events)
+
+	out, err := captureStdout(func() error {
+		printTrackResult(&TrackResult{Status: TrackStatusNotFound, DestinationFromBlock: 25, DestinationToBlock: 49})
+		return nil
+	})
+	s.Nil(err)
+	s.Contains(out, "Status: not found")
+	s.Contains(out, "No proposal found in destination blocks 25-49")
+}
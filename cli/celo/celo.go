@@ -0,0 +1,239 @@
+package celo
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/erc20handler"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/registry"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	callsUtil "github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	bridgeContract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	erc20Contract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/erc20"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// CeloDecimals is the number of decimals of native CELO
+const CeloDecimals = 18
+
+var CeloCmd = &cobra.Command{
+	Use:   "celo",
+	Short: "Set of commands for bridging native CELO",
+	Long:  "Set of commands for bridging native CELO through its GoldToken ERC20 interface and the ERC20 handler",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		// fetch global flag values
+		url, gasLimit, gasPrice, senderKeyPair, prepare, err = flags.GlobalFlagValues(cmd)
+		if err != nil {
+			return fmt.Errorf("could not get global flags: %v", err)
+		}
+		return nil
+	},
+}
+
+var registerResourceCmd = &cobra.Command{
+	Use:   "register-resource",
+	Short: "Register GoldToken as a bridge resource",
+	Long:  "The register-resource subcommand registers the GoldToken contract for a resource ID in the ERC20 handler. The resource is not set burnable, deposited CELO is locked in the handler",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return RegisterResourceCmd(cmd, args, bridgeContract.NewBridgeContract(c, BridgeAddr, t), registry.NewRegistryContract(c, registry.RegistryAddress))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateRegisterResourceFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessRegisterResourceFlags(cmd, args)
+	},
+}
+
+var depositCmd = &cobra.Command{
+	Use:   "deposit",
+	Short: "Deposit native CELO",
+	Long:  "The deposit subcommand approves the ERC20 handler to transfer CELO through GoldToken, unless its allowance already covers the amount, and deposits it on the bridge",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		opts, err := initialize.InitializeTransactOpts(senderKeyPair)
+		if err != nil {
+			return err
+		}
+		goldToken, err := registry.NewRegistryContract(c, registry.RegistryAddress).GetAddressFor(registry.GoldTokenID)
+		if err != nil {
+			return err
+		}
+		bridge := bridgeContract.NewBridgeContract(c, BridgeAddr, t)
+		handlerAddr, err := bridge.GetHandlerAddressForResourceID(ResourceIdBytesArr)
+		if err != nil {
+			return err
+		}
+		return DepositCmd(
+			cmd,
+			args,
+			bridge,
+			erc20handler.NewERC20HandlerContract(c, handlerAddr),
+			erc20Contract.NewERC20Contract(c, goldToken, t),
+			opts.From,
+		)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateDepositFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessDepositFlags(cmd, args)
+	},
+}
+
+func init() {
+	BindRegisterResourceFlags(registerResourceCmd)
+	BindDepositFlags(depositCmd)
+	CeloCmd.AddCommand(
+		registerResourceCmd,
+		depositCmd,
+	)
+}
+
+func BindRegisterResourceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Address of bridge contract")
+	cmd.Flags().StringVar(&Handler, "handler", "", "Address of ERC20 handler contract")
+	cmd.Flags().StringVar(&ResourceID, "resource", "", "Resource ID to register native CELO with")
+	flags.MarkFlagsAsRequired(cmd, "bridge", "handler", "resource")
+}
+
+func ValidateRegisterResourceFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	if !common.IsHexAddress(Handler) {
+		return fmt.Errorf("invalid handler address %s", Handler)
+	}
+	return nil
+}
+
+func ProcessRegisterResourceFlags(cmd *cobra.Command, args []string) error {
+	var err error
+	BridgeAddr = common.HexToAddress(Bridge)
+	HandlerAddr = common.HexToAddress(Handler)
+	ResourceIdBytesArr, err = flags.ProcessResourceID(ResourceID)
+	return err
+}
+
+func RegisterResourceCmd(cmd *cobra.Command, args []string, bridge *bridgeContract.BridgeContract, r *registry.RegistryContract) error {
+	goldToken, err := r.GetAddressFor(registry.GoldTokenID)
+	if err != nil {
+		return err
+	}
+	h, err := bridge.AdminSetResource(HandlerAddr, ResourceIdBytesArr, goldToken, transactor.TransactOptions{GasLimit: gasLimit})
+	if err != nil {
+		log.Error().Err(err).Msg("failed registering GoldToken resource")
+		return err
+	}
+	log.Info().Msgf("GoldToken %s registered with resource ID %s on handler %s in %s", goldToken.Hex(), ResourceID, HandlerAddr.Hex(), h.Hex())
+	return nil
+}
+
+func BindDepositFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&Recipient, "recipient", "", "Address of recipient")
+	cmd.Flags().StringVar(&Bridge, "bridge", "", "Address of bridge contract")
+	cmd.Flags().StringVar(&Amount, "amount", "", "Amount of CELO to deposit")
+	cmd.Flags().Uint8Var(&DomainID, "domain", 0, "Destination domain ID")
+	cmd.Flags().StringVar(&ResourceID, "resource", "", "Resource ID of native CELO")
+	flags.MarkFlagsAsRequired(cmd, "recipient", "bridge", "amount", "domain", "resource")
+}
+
+func ValidateDepositFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(Recipient) {
+		return fmt.Errorf("invalid recipient address %s", Recipient)
+	}
+	if !common.IsHexAddress(Bridge) {
+		return fmt.Errorf("invalid bridge address %s", Bridge)
+	}
+	return nil
+}
+
+func ProcessDepositFlags(cmd *cobra.Command, args []string) error {
+	var err error
+	RecipientAddress = common.HexToAddress(Recipient)
+	BridgeAddr = common.HexToAddress(Bridge)
+	RealAmount, err = callsUtil.UserAmountToWei(Amount, big.NewInt(CeloDecimals))
+	if err != nil {
+		return err
+	}
+	ResourceIdBytesArr, err = flags.ProcessResourceID(ResourceID)
+	return err
+}
+
+func DepositCmd(
+	cmd *cobra.Command,
+	args []string,
+	bridge *bridgeContract.BridgeContract,
+	handler *erc20handler.ERC20HandlerContract,
+	goldToken *erc20Contract.ERC20Contract,
+	sender common.Address,
+) error {
+	token, err := handler.TokenAddress(ResourceIdBytesArr)
+	if err != nil {
+		return err
+	}
+	if token != *goldToken.ContractAddress() {
+		return fmt.Errorf("resource %s is registered for %s instead of GoldToken %s", ResourceID, token.Hex(), goldToken.ContractAddress().Hex())
+	}
+
+	allowance, err := goldTokenAllowance(goldToken, sender, *handler.ContractAddress())
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(RealAmount) < 0 {
+		h, err := goldToken.ApproveTokens(*handler.ContractAddress(), RealAmount, transactor.TransactOptions{GasLimit: gasLimit})
+		if err != nil {
+			log.Error().Err(err).Msg("GoldToken approve error")
+			return err
+		}
+		log.Info().Msgf("%s CELO approved for handler %s in %s", Amount, handler.ContractAddress().Hex(), h.Hex())
+	}
+
+	h, err := bridge.Erc20Deposit(RecipientAddress, RealAmount, ResourceIdBytesArr, DomainID, transactor.TransactOptions{GasLimit: gasLimit})
+	if err != nil {
+		log.Error().Err(err).Msg("CELO deposit error")
+		return err
+	}
+	log.Info().Msgf("%s CELO were locked for %s in %s", Amount, RecipientAddress.Hex(), h.Hex())
+	return nil
+}
+
+// goldTokenAllowance returns the CELO owner allowed spender to transfer through GoldToken
+func goldTokenAllowance(goldToken *erc20Contract.ERC20Contract, owner common.Address, spender common.Address) (*big.Int, error) {
+	res, err := goldToken.CallContract("allowance", owner, spender)
+	if err != nil {
+		return nil, err
+	}
+	return abi.ConvertType(res[0], new(big.Int)).(*big.Int), nil
+}
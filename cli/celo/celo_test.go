@@ -0,0 +1,84 @@
+package celo
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/erc20handler"
+	"github.com/ChainSafe/chainbridge-celo-module/internal/calltest"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	bridgeContract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	erc20Contract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/erc20"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeTransactor records the contracts transactions are sent to
+type fakeTransactor struct {
+	sent []common.Address
+}
+
+func (t *fakeTransactor) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+	t.sent = append(t.sent, *to)
+	return &common.Hash{}, nil
+}
+
+type DepositTestSuite struct {
+	suite.Suite
+	caller     *calltest.ContractCaller
+	transactor *fakeTransactor
+	bridge     common.Address
+	handler    common.Address
+	goldToken  common.Address
+	sender     common.Address
+}
+
+func TestRunDepositTestSuite(t *testing.T) {
+	suite.Run(t, new(DepositTestSuite))
+}
+
+func (s *DepositTestSuite) SetupTest() {
+	s.bridge = common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+	s.handler = common.HexToAddress("0x3167776db165D8eA0f51790CA2bbf44Db5105ADF")
+	s.goldToken = common.HexToAddress("0xF194afDf50B03e69Bd7D057c1Aa9e10c9954E4C9")
+	s.sender = common.HexToAddress("0xff93B45308FD417dF303D6515aB04D9e89a750Ca")
+	s.caller = calltest.NewContractCaller()
+	s.transactor = &fakeTransactor{}
+	s.caller.Respond(s.handler, consts.ERC20HandlerABI, "_resourceIDToTokenContractAddress", s.goldToken)
+	RealAmount = big.NewInt(100)
+}
+
+func (s *DepositTestSuite) deposit() error {
+	return DepositCmd(
+		nil,
+		nil,
+		bridgeContract.NewBridgeContract(s.caller, s.bridge, s.transactor),
+		erc20handler.NewERC20HandlerContract(s.caller, s.handler),
+		erc20Contract.NewERC20Contract(s.caller, s.goldToken, s.transactor),
+		s.sender,
+	)
+}
+
+func (s *DepositTestSuite) TestRejectsResourceOfOtherToken() {
+	s.caller.Respond(s.handler, consts.ERC20HandlerABI, "_resourceIDToTokenContractAddress", common.HexToAddress("0x1"))
+
+	err := s.deposit()
+	s.NotNil(err)
+	s.Contains(err.Error(), "instead of GoldToken")
+	s.Empty(s.transactor.sent)
+}
+
+func (s *DepositTestSuite) TestApprovesMissingAllowance() {
+	s.caller.Respond(s.goldToken, consts.ERC20PresetMinterPauserABI, "allowance", big.NewInt(99))
+
+	s.Nil(s.deposit())
+	s.Equal([]common.Address{s.goldToken, s.bridge}, s.transactor.sent)
+}
+
+func (s *DepositTestSuite) TestSkipsApproveWithAllowance() {
+	s.caller.Respond(s.goldToken, consts.ERC20PresetMinterPauserABI, "allowance", big.NewInt(100))
+
+	s.Nil(s.deposit())
+	s.Equal([]common.Address{s.bridge}, s.transactor.sent)
+}
@@ -0,0 +1,37 @@
+package celo
+
+import (
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+//flag vars
+var (
+	Amount     string
+	Recipient  string
+	Bridge     string
+	Handler    string
+	DomainID   uint8
+	ResourceID string
+)
+
+//processed flag vars
+var (
+	RecipientAddress   common.Address
+	RealAmount         *big.Int
+	BridgeAddr         common.Address
+	HandlerAddr        common.Address
+	ResourceIdBytesArr types.ResourceID
+)
+
+// global flags
+var (
+	url           string
+	gasLimit      uint64
+	gasPrice      *big.Int
+	senderKeyPair *secp256k1.Keypair
+	prepare       bool
+)
@@ -1,10 +1,17 @@
 package cli
 
 import (
+	"github.com/ChainSafe/chainbridge-celo-module/cli/account"
 	"github.com/ChainSafe/chainbridge-celo-module/cli/admin"
 	"github.com/ChainSafe/chainbridge-celo-module/cli/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/cli/celo"
 	"github.com/ChainSafe/chainbridge-celo-module/cli/deploy"
+	"github.com/ChainSafe/chainbridge-celo-module/cli/doctor"
 	"github.com/ChainSafe/chainbridge-celo-module/cli/erc20"
+	"github.com/ChainSafe/chainbridge-celo-module/cli/erc721"
+	"github.com/ChainSafe/chainbridge-celo-module/cli/flags"
+	"github.com/ChainSafe/chainbridge-celo-module/cli/sign"
+	"github.com/ChainSafe/chainbridge-celo-module/cli/tx"
 	evmCLI "github.com/ChainSafe/chainbridge-core/chains/evm/cli"
 	"github.com/spf13/cobra"
 )
@@ -18,6 +25,7 @@ var CeloRootCLI = &cobra.Command{
 func init() {
 	// persistent flags
 	evmCLI.BindEVMCLIFlags(CeloRootCLI)
+	flags.BindCeloCLIFlags(CeloRootCLI)
 
 	// add commands to celo-cli root
 	// deploy
@@ -32,6 +40,21 @@ func init() {
 	// // erc20
 	CeloRootCLI.AddCommand(erc20.ERC20CeloCmd)
 
+	// celo
+	CeloRootCLI.AddCommand(celo.CeloCmd)
+
+	// sign-typed-data
+	CeloRootCLI.AddCommand(sign.SignTypedDataCeloCmd)
+
 	// // erc721
-	// celoRootCLI.AddCommand(erc721.ERC721Cmd)
+	CeloRootCLI.AddCommand(erc721.ERC721CeloCmd)
+
+	// tx
+	CeloRootCLI.AddCommand(tx.TxCeloCmd)
+
+	// account
+	CeloRootCLI.AddCommand(account.AccountCeloCmd)
+
+	// doctor
+	CeloRootCLI.AddCommand(doctor.DoctorCeloCmd)
 }
@@ -1,10 +1,15 @@
 package deploy
 
 import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/flags"
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
 	"github.com/ChainSafe/chainbridge-celo-module/transaction"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer"
 	coreDeployCLI "github.com/ChainSafe/chainbridge-core/chains/evm/cli/deploy"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var DeployCeloCmd = &cobra.Command{
@@ -12,10 +17,16 @@ var DeployCeloCmd = &cobra.Command{
 	Short: "Deploy smart contracts",
 	Long:  "This command can be used to deploy all or some of the contracts required for bridging. Selection of contracts can be made by either specifying --all or a subset of flags",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		initialize.InitializeReplayProtection()
 		txFabric := transaction.NewCeloTransaction
 		return coreDeployCLI.DeployCLI(cmd, args, txFabric, &evmgaspricer.StaticGasPriceDeterminant{})
 	},
 	Args: func(cmd *cobra.Command, args []string) error {
+		// deployments are sent through the chainbridge-core client, which
+		// waits for receipts itself
+		if viper.GetBool(flags.WaitFlagName) {
+			return fmt.Errorf("--%s is not supported by deploy", flags.WaitFlagName)
+		}
 		err := coreDeployCLI.ValidateDeployFlags(cmd, args)
 		if err != nil {
 			return err
@@ -0,0 +1,133 @@
+package doctor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	celo "github.com/ChainSafe/chainbridge-celo-module"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ChainSafe/chainbridge-core/config"
+	"github.com/spf13/cobra"
+)
+
+var DoctorCeloCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose relayer configuration",
+	Long:  "The doctor command loads the celo chains of a relayer configuration file and checks the chain ID, domain ID, relayer role, paused state, account balance, blockstore and handler registrations of each, printing a remediation hint for every failed check. It exits with an error if any check fails",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return DoctorCmd(cmd, args)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateDoctorFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessDoctorFlags(cmd, args)
+	},
+}
+
+func BindDoctorFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&ConfigPath, "config", "", "Relayer configuration file")
+	cmd.Flags().StringVar(&Chain, "chain", "", "Name of the diagnosed chain, every celo chain if not set")
+	cmd.Flags().StringVar(&BlockstorePath, "blockstore", "./lvldbdata", "Blockstore path the relayer runs with")
+	cmd.Flags().Uint64Var(&MaxBlockLag, "max-block-lag", celo.DefaultMaxBlockLag, "Number of blocks the blockstore may be behind the latest block")
+	cmd.Flags().BoolVar(&JSON, "json", false, "Print the result as JSON")
+	flags.MarkFlagsAsRequired(cmd, "config")
+}
+
+func init() {
+	BindDoctorFlags(DoctorCeloCmd)
+}
+
+func ValidateDoctorFlags(cmd *cobra.Command, args []string) error {
+	if MaxBlockLag == 0 {
+		return errors.New("max-block-lag should be greater than 0")
+	}
+	return nil
+}
+
+func ProcessDoctorFlags(cmd *cobra.Command, args []string) error {
+	cfg, err := config.GetConfig(ConfigPath)
+	if err != nil {
+		return err
+	}
+	ChainConfigs = nil
+	for _, chainConfig := range cfg.ChainConfigs {
+		// only celo chains are set up with SetupCeloChain
+		if chainConfig["type"] != "celo" {
+			continue
+		}
+		if Chain != "" && chainConfig["name"] != Chain {
+			continue
+		}
+		ChainConfigs = append(ChainConfigs, chainConfig)
+	}
+	if len(ChainConfigs) == 0 {
+		if Chain != "" {
+			return fmt.Errorf("no celo chain named %s found in config", Chain)
+		}
+		return errors.New("no celo chains found in config")
+	}
+	return nil
+}
+
+// ChainDiagnosis is the doctor output of a single chain
+type ChainDiagnosis struct {
+	Chain  string             `json:"chain"`
+	Checks []celo.CheckResult `json:"checks"`
+}
+
+func DoctorCmd(cmd *cobra.Command, args []string) error {
+	diagnoses := make([]ChainDiagnosis, 0, len(ChainConfigs))
+	for _, chainConfig := range ChainConfigs {
+		doctor, err := celo.NewDoctor(chainConfig, BlockstorePath, MaxBlockLag)
+		if err != nil {
+			return fmt.Errorf("invalid config of chain %v: %w", chainConfig["name"], err)
+		}
+		diagnoses = append(diagnoses, ChainDiagnosis{Chain: doctor.Name(), Checks: doctor.Run()})
+	}
+
+	failed := 0
+	for _, d := range diagnoses {
+		for _, check := range d.Checks {
+			if check.Failed() {
+				failed++
+			}
+		}
+	}
+
+	if JSON {
+		out, err := json.MarshalIndent(diagnoses, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	} else {
+		for _, d := range diagnoses {
+			printDiagnosis(d)
+		}
+	}
+
+	if failed > 0 {
+		// the checks are already printed, usage would only hide them
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d checks failed", failed)
+	}
+	return nil
+}
+
+func printDiagnosis(d ChainDiagnosis) {
+	fmt.Printf("\n%s\n", d.Chain)
+	for _, check := range d.Checks {
+		fmt.Printf("  [%s] %s: %s\n", strings.ToUpper(check.Status), check.Name, check.Message)
+		if check.Hint != "" && check.Status != celo.CheckPass {
+			fmt.Printf("         hint: %s\n", check.Hint)
+		}
+	}
+}
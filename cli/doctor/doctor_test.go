@@ -0,0 +1,49 @@
+package doctor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+)
+
+type DoctorCmdTestSuite struct {
+	suite.Suite
+}
+
+func TestRunDoctorCmdTestSuite(t *testing.T) {
+	suite.Run(t, new(DoctorCmdTestSuite))
+}
+
+func (s *DoctorCmdTestSuite) TearDownTest() {
+	ChainConfigs = nil
+	JSON = false
+}
+
+func (s *DoctorCmdTestSuite) TestFailsIfAnyCheckFails() {
+	ChainConfigs = []map[string]interface{}{{
+		"name":     "celo",
+		"type":     "celo",
+		"id":       uint8(1),
+		"endpoint": "http://127.0.0.1:1",
+		"from":     "0xff93B45308FD417dF303D6515aB04D9e89a750Ca",
+		"bridge":   "0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B",
+		"chainId":  44787,
+	}}
+	BlockstorePath = filepath.Join(s.T().TempDir(), "lvldbdata")
+	MaxBlockLag = 1000
+	JSON = true
+	cmd := &cobra.Command{}
+
+	err := DoctorCmd(cmd, nil)
+	s.NotNil(err)
+	s.Regexp("^[0-9]+ checks failed$", err.Error())
+	s.True(cmd.SilenceUsage)
+}
+
+func (s *DoctorCmdTestSuite) TestFailsOnInvalidChainConfig() {
+	ChainConfigs = []map[string]interface{}{{"name": "celo", "type": "celo"}}
+
+	s.NotNil(DoctorCmd(&cobra.Command{}, nil))
+}
@@ -0,0 +1,15 @@
+package doctor
+
+//flag vars
+var (
+	ConfigPath     string
+	Chain          string
+	BlockstorePath string
+	MaxBlockLag    uint64
+	JSON           bool
+)
+
+//processed flag vars
+var (
+	ChainConfigs []map[string]interface{}
+)
@@ -3,12 +3,12 @@ package erc20
 import (
 	"fmt"
 
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
 	"github.com/ChainSafe/chainbridge-celo-module/transaction"
 	bridgeContract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
 	erc20Contract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/erc20"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/erc20"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
-	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/initialize"
 	"github.com/spf13/cobra"
 )
 
@@ -19,7 +19,7 @@ var ERC20CeloCmd = &cobra.Command{
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		var err error
 		// fetch global flag values
-		url, _, gasPrice, senderKeyPair, prepare, err = flags.GlobalFlagValues(cmd)
+		url, gasLimit, gasPrice, senderKeyPair, prepare, err = flags.GlobalFlagValues(cmd)
 		if err != nil {
 			return fmt.Errorf("could not get global flags: %v", err)
 		}
@@ -129,6 +129,13 @@ var depositCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		if Permit {
+			opts, err := initialize.InitializeTransactOpts(senderKeyPair)
+			if err != nil {
+				return err
+			}
+			return PermitDepositCmd(cmd, args, c, t, opts)
+		}
 		return erc20.DepositCmd(
 			cmd,
 			args,
@@ -148,7 +155,7 @@ var depositCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		return nil
+		return ValidatePermitFlags(cmd, args)
 	},
 }
 
@@ -221,6 +228,7 @@ var balanceCmd = &cobra.Command{
 func init() {
 	erc20.BindApproveFlags(approveCmd)
 	erc20.BindDepositFlags(depositCmd)
+	BindPermitFlags(depositCmd)
 	erc20.BindAddMinterFlags(addMinterCmd)
 	erc20.BindGetAllowanceFlags(allowanceCmd)
 	erc20.BindMintFlags(mintCmd)
@@ -11,6 +11,8 @@ import (
 
 //flag vars
 var (
+	Permit         bool
+	PermitDeadline uint64
 	Amount         string
 	Decimals       uint64
 	DstAddress     string
@@ -38,6 +40,7 @@ var (
 // global flags
 var (
 	url           string
+	gasLimit      uint64
 	gasPrice      *big.Int
 	senderKeyPair *secp256k1.Keypair
 	prepare       bool
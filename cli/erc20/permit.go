@@ -0,0 +1,184 @@
+package erc20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/erc20handler"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/erc20permit"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	bridgeContract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/erc20"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// DefaultPermitDeadline is the default time in seconds a deposit permit stays valid
+const DefaultPermitDeadline = 3600
+
+func BindPermitFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&Permit, "permit", false, "Authorize the handler with an EIP-2612 permit sent along with the deposit instead of a separate approve transaction. Falls back to approve if the ERC20 handler does not redeem permits")
+	cmd.Flags().Uint64Var(&PermitDeadline, "permit-deadline", DefaultPermitDeadline, "Time in seconds the permit stays valid")
+}
+
+func ValidatePermitFlags(cmd *cobra.Command, args []string) error {
+	if Permit && prepare {
+		return errors.New("--permit can't be used with --prepare")
+	}
+	return nil
+}
+
+// PermitDepositCmd deposits ERC20 tokens authorizing the handler with a permit
+// appended to the deposit data. If the token doesn't support permits, or the
+// simulated deposit reverts because the handler doesn't redeem them, the
+// handler is approved with a separate transaction instead. Other reverts of the
+// simulated deposit are returned.
+func PermitDepositCmd(
+	cmd *cobra.Command,
+	args []string,
+	client *evmclient.EVMClient,
+	t transactor.Transactor,
+	opts *transaction.TransactOpts,
+) error {
+	bridge := bridgeContract.NewBridgeContract(client, erc20.BridgeAddr, t)
+	handlerAddr, err := bridge.GetHandlerAddressForResourceID(erc20.ResourceIdBytesArr)
+	if err != nil {
+		return err
+	}
+	tokenAddr, err := erc20handler.NewERC20HandlerContract(client, handlerAddr).TokenAddress(erc20.ResourceIdBytesArr)
+	if err != nil {
+		return err
+	}
+	token := erc20permit.NewERC20PermitContract(client, tokenAddr, t)
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		return err
+	}
+
+	data, err := permitDepositData(token, chainID, opts, handlerAddr)
+	if errors.Is(err, erc20permit.ErrPermitNotSupported) {
+		log.Warn().Msgf("Token %s does not support permit, approving handler %s instead", tokenAddr.Hex(), handlerAddr.Hex())
+		return approveAndDeposit(bridge, token, opts, handlerAddr)
+	}
+	if err != nil {
+		return err
+	}
+	// a handler that doesn't redeem permits ignores them and reverts when
+	// transferring the tokens, so the deposit is simulated before it is sent
+	err = simulatePermitDeposit(client, bridge, opts.From, data)
+	var revertErr *transaction.RevertError
+	if errors.As(err, &revertErr) && permitNotRedeemed(revertErr.Reason) {
+		log.Warn().Msgf("Deposit with permit would revert (%s), handler %s does not redeem permits, approving it instead", revertErr, handlerAddr.Hex())
+		return approveAndDeposit(bridge, token, opts, handlerAddr)
+	}
+	if err != nil {
+		return fmt.Errorf("deposit with permit would fail: %w", err)
+	}
+
+	hash, err := bridge.ExecuteTransaction(
+		"deposit",
+		transactor.TransactOptions{GasLimit: gasLimit},
+		erc20.DomainID, erc20.ResourceIdBytesArr, data,
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("erc20 permit deposit error")
+		return err
+	}
+	log.Info().Msgf(
+		"%s tokens were transferred to %s from %s with permit in %s",
+		erc20.Amount, erc20.RecipientAddress.Hex(), opts.From.Hex(), hash.Hex(),
+	)
+	return nil
+}
+
+func permitDepositData(
+	token *erc20permit.ERC20PermitContract,
+	chainID *big.Int,
+	opts *transaction.TransactOpts,
+	handlerAddr common.Address,
+) ([]byte, error) {
+	domain, err := token.PermitDomain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := token.Nonces(opts.From)
+	if err != nil {
+		return nil, err
+	}
+	deadline := big.NewInt(time.Now().Unix() + int64(PermitDeadline))
+	signature, err := opts.SignTypedData(erc20permit.PermitTypedData(domain, opts.From, handlerAddr, erc20.RealAmount, nonce, deadline))
+	if err != nil {
+		return nil, err
+	}
+	return erc20permit.ConstructErc20PermitDepositData(erc20.RecipientAddress.Bytes(), erc20.RealAmount, deadline, signature), nil
+}
+
+// simulatePermitDeposit calls deposit with the permit deposit data from sender.
+// A revert is returned as a RevertError with the decoded reason.
+func simulatePermitDeposit(
+	client *evmclient.EVMClient,
+	bridge *bridgeContract.BridgeContract,
+	sender common.Address,
+	data []byte,
+) error {
+	input, err := bridge.PackMethod("deposit", erc20.DomainID, erc20.ResourceIdBytesArr, data)
+	if err != nil {
+		return err
+	}
+	abis, err := transaction.BridgeABIs()
+	if err != nil {
+		return err
+	}
+	msg := ethereum.CallMsg{From: sender, To: &erc20.BridgeAddr, Data: input}
+	_, err = client.CallContract(context.Background(), calls.ToCallArg(msg), nil)
+	return transaction.WrapRevert(err, abis...)
+}
+
+// permitNotRedeemed reports whether a deposit with permit reverted with reason
+// because the handler ignored the permit: the token transfer then fails for
+// lack of allowance, or reverts without data on tokens without revert reasons.
+func permitNotRedeemed(reason string) bool {
+	return reason == "" || strings.Contains(strings.ToLower(reason), "allowance")
+}
+
+func approveAndDeposit(
+	bridge *bridgeContract.BridgeContract,
+	token *erc20permit.ERC20PermitContract,
+	opts *transaction.TransactOpts,
+	handlerAddr common.Address,
+) error {
+	allowance, err := token.Allowance(opts.From, handlerAddr)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(erc20.RealAmount) < 0 {
+		hash, err := token.ApproveTokens(handlerAddr, erc20.RealAmount, transactor.TransactOptions{GasLimit: gasLimit})
+		if err != nil {
+			return err
+		}
+		log.Info().Msgf("%s tokens approved for handler %s in %s", erc20.Amount, handlerAddr.Hex(), hash.Hex())
+	}
+
+	hash, err := bridge.Erc20Deposit(
+		erc20.RecipientAddress, erc20.RealAmount, erc20.ResourceIdBytesArr,
+		erc20.DomainID, transactor.TransactOptions{GasLimit: gasLimit},
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("erc20 deposit error")
+		return err
+	}
+	log.Info().Msgf(
+		"%s tokens were transferred to %s from %s with hash %s",
+		erc20.Amount, erc20.RecipientAddress.Hex(), opts.From.Hex(), hash.Hex(),
+	)
+	return nil
+}
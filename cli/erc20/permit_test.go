@@ -0,0 +1,33 @@
+package erc20
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PermitTestSuite struct {
+	suite.Suite
+}
+
+func TestRunPermitTestSuite(t *testing.T) {
+	suite.Run(t, new(PermitTestSuite))
+}
+
+func (s *PermitTestSuite) TestPermitNotRedeemed() {
+	testcases := []struct {
+		reason      string
+		notRedeemed bool
+	}{
+		{"", true},
+		{"ERC20: insufficient allowance", true},
+		{"ERC20: transfer amount exceeds allowance", true},
+		{"ERC20: burn amount exceeds allowance", true},
+		{"ERC20: transfer amount exceeds balance", false},
+		{"provided tokenAddress is not whitelisted", false},
+		{"Pausable: paused", false},
+	}
+	for _, tc := range testcases {
+		s.Equal(tc.notRedeemed, permitNotRedeemed(tc.reason), tc.reason)
+	}
+}
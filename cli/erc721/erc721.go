@@ -0,0 +1,245 @@
+package erc721
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	bridgeContract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	erc721Contract "github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/erc721"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/erc721"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var ERC721CeloCmd = &cobra.Command{
+	Use:   "erc721",
+	Short: "Set of commands for interacting with an ERC721 contract",
+	Long:  "Set of commands for interacting with an ERC721 contract",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		// fetch global flag values
+		url, gasLimit, gasPrice, senderKeyPair, prepare, err = flags.GlobalFlagValues(cmd)
+		if err != nil {
+			return fmt.Errorf("could not get global flags: %v", err)
+		}
+		return nil
+	},
+}
+
+var addMinterCmd = &cobra.Command{
+	Use:   "add-minter",
+	Short: "Add a new ERC721 minter",
+	Long:  "The add-minter subcommand adds a new minter address to an ERC721 mintable contract",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return erc721.AddMinterCmd(
+			cmd,
+			args,
+			erc721Contract.NewErc721Contract(
+				c,
+				erc721.Erc721Addr,
+				t,
+			))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := erc721.ValidateAddMinterFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		err = erc721.ProcessAddMinterFlags(cmd, args)
+		return err
+	},
+}
+
+var approveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "Approve an ERC721 token",
+	Long:  "The approve subcommand approves a token in an ERC721 contract for transfer",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return erc721.ApproveCmd(
+			cmd,
+			args,
+			erc721Contract.NewErc721Contract(
+				c,
+				erc721.Erc721Addr,
+				t,
+			))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := erc721.ValidateApproveFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		err = erc721.ProcessApproveFlags(cmd, args)
+		return err
+	},
+}
+
+var depositCmd = &cobra.Command{
+	Use:   "deposit",
+	Short: "Deposit an ERC721 token",
+	Long:  "The deposit subcommand creates a new ERC721 token deposit on the bridge contract",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return DepositCmd(
+			cmd,
+			args,
+			bridgeContract.NewBridgeContract(
+				c,
+				erc721.BridgeAddr,
+				t,
+			))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := erc721.ValidateDepositFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		err = erc721.ProcessDepositFlags(cmd, args)
+		return err
+	},
+}
+
+var mintCmd = &cobra.Command{
+	Use:   "mint",
+	Short: "Mint an ERC721 token",
+	Long:  "The mint subcommand mints a token on an ERC721 mintable contract",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return erc721.MintCmd(
+			cmd,
+			args,
+			erc721Contract.NewErc721Contract(
+				c,
+				erc721.Erc721Addr,
+				t,
+			))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := erc721.ValidateMintFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		err = erc721.ProcessMintFlags(cmd, args)
+		return err
+	},
+}
+
+var ownerCmd = &cobra.Command{
+	Use:   "owner",
+	Short: "Get an ERC721 token owner",
+	Long:  "The owner subcommand gets a token owner from an ERC721 mintable contract",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, transaction.NewCeloTransaction, c, prepare)
+		if err != nil {
+			return err
+		}
+		return erc721.OwnerCmd(
+			cmd,
+			args,
+			erc721Contract.NewErc721Contract(
+				c,
+				erc721.Erc721Addr,
+				t,
+			))
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := erc721.ValidateOwnerFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		err = erc721.ProcessOwnerFlags(cmd, args)
+		return err
+	},
+}
+
+// DepositCmd deposits the ERC721 token selected with the core deposit flags
+func DepositCmd(cmd *cobra.Command, args []string, bridge *bridgeContract.BridgeContract) error {
+	txHash, err := bridge.Erc721Deposit(
+		erc721.TokenId, erc721.Metadata, erc721.RecipientAddr, erc721.ResourceId, uint8(erc721.DestinationID),
+		transactor.TransactOptions{GasLimit: gasLimit},
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Msgf(
+		"%s token was transferred to %s with hash %s",
+		erc721.TokenId.String(),
+		erc721.RecipientAddr.Hex(),
+		txHash.Hex(),
+	)
+	return nil
+}
+
+func init() {
+	erc721.BindAddMinterFlags(addMinterCmd)
+	erc721.BindApproveFlags(approveCmd)
+	erc721.BindDepositFlags(depositCmd)
+	erc721.BindMintFlags(mintCmd)
+	erc721.BindOwnerFlags(ownerCmd)
+	ERC721CeloCmd.AddCommand(
+		addMinterCmd,
+		approveCmd,
+		depositCmd,
+		mintCmd,
+		ownerCmd,
+	)
+}
@@ -0,0 +1,16 @@
+package erc721
+
+import (
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+)
+
+// global flags
+var (
+	url           string
+	gasLimit      uint64
+	gasPrice      *big.Int
+	senderKeyPair *secp256k1.Keypair
+	prepare       bool
+)
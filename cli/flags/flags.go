@@ -0,0 +1,46 @@
+package flags
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	// Flags for all Celo CLI commands
+	SignerFlagName           = "signer"
+	SignerURLFlagName        = "signer-url"
+	SignerKeyFlagName        = "signer-key"
+	SignerTokenFlagName      = "signer-token"
+	SignerAddressFlagName    = "signer-address"
+	AllowUnprotectedFlagName = "allow-unprotected"
+	WaitFlagName             = "wait"
+	WaitTimeoutFlagName      = "wait-timeout"
+)
+
+// DefaultWaitTimeout is how long receipts are waited for, which matches the
+// receipt polling of the chainbridge-core transactor
+const DefaultWaitTimeout = 250 * time.Second
+
+// BindCeloCLIFlags binds Celo specific global flags. They complement the flags
+// bound by evmCLI.BindEVMCLIFlags.
+func BindCeloCLIFlags(celoRootCLI *cobra.Command) {
+	celoRootCLI.PersistentFlags().String(SignerFlagName, "local", "Signer backend used to sign transactions (local, clef or transit)")
+	celoRootCLI.PersistentFlags().String(SignerURLFlagName, "", "URL of the remote signer")
+	celoRootCLI.PersistentFlags().String(SignerKeyFlagName, "", "Name of the key in the transit signer")
+	celoRootCLI.PersistentFlags().String(SignerTokenFlagName, "", "Token of the transit signer, read from the CELO_SIGNER_TOKEN environment variable if unset")
+	celoRootCLI.PersistentFlags().String(SignerAddressFlagName, "", "Address of the account held by the remote signer")
+	celoRootCLI.PersistentFlags().Bool(AllowUnprotectedFlagName, false, "Allow signing transactions without EIP-155 replay protection")
+	celoRootCLI.PersistentFlags().Bool(WaitFlagName, false, "Wait for the receipt of sent transactions and print their status, fees and revert reason")
+	celoRootCLI.PersistentFlags().Duration(WaitTimeoutFlagName, DefaultWaitTimeout, "Maximum time to wait for a receipt with --wait")
+
+	_ = viper.BindPFlag(SignerFlagName, celoRootCLI.PersistentFlags().Lookup(SignerFlagName))
+	_ = viper.BindPFlag(SignerURLFlagName, celoRootCLI.PersistentFlags().Lookup(SignerURLFlagName))
+	_ = viper.BindPFlag(SignerKeyFlagName, celoRootCLI.PersistentFlags().Lookup(SignerKeyFlagName))
+	_ = viper.BindPFlag(SignerTokenFlagName, celoRootCLI.PersistentFlags().Lookup(SignerTokenFlagName))
+	_ = viper.BindPFlag(SignerAddressFlagName, celoRootCLI.PersistentFlags().Lookup(SignerAddressFlagName))
+	_ = viper.BindPFlag(AllowUnprotectedFlagName, celoRootCLI.PersistentFlags().Lookup(AllowUnprotectedFlagName))
+	_ = viper.BindPFlag(WaitFlagName, celoRootCLI.PersistentFlags().Lookup(WaitFlagName))
+	_ = viper.BindPFlag(WaitTimeoutFlagName, celoRootCLI.PersistentFlags().Lookup(WaitTimeoutFlagName))
+}
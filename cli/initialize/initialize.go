@@ -0,0 +1,130 @@
+package initialize
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/flags"
+	celoClient "github.com/ChainSafe/chainbridge-celo-module/client"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/prepare"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/initialize"
+	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
+)
+
+func InitializeClient(
+	url string,
+	senderKeyPair *secp256k1.Keypair,
+) (*evmclient.EVMClient, error) {
+	return initialize.InitializeClient(url, senderKeyPair)
+}
+
+// SignerConfig returns the signer selected through global CLI flags
+func SignerConfig() celoClient.SignerConfig {
+	return celoClient.SignerConfig{
+		Type:  viper.GetString(flags.SignerFlagName),
+		URL:   viper.GetString(flags.SignerURLFlagName),
+		Key:   viper.GetString(flags.SignerKeyFlagName),
+		Token: viper.GetString(flags.SignerTokenFlagName),
+	}
+}
+
+// InitializeSigningClient returns the client used to sign and send transactions.
+// If a remote signer is selected, transactions are sent from --signer-address and
+// signed by the remote signer, otherwise the client itself is returned.
+func InitializeSigningClient(client *evmclient.EVMClient) (calls.ClientDispatcher, error) {
+	signer := SignerConfig()
+	if err := signer.Validate(); err != nil {
+		return nil, err
+	}
+	if !signer.IsRemote() {
+		return client, nil
+	}
+	opts, err := remoteTransactOpts(signer)
+	if err != nil {
+		return nil, err
+	}
+	return celoClient.NewCeloClient(client, opts), nil
+}
+
+// InitializeTransactOpts returns the TransactOpts of the selected signer. The
+// sender key pair is used if no remote signer is selected.
+func InitializeTransactOpts(senderKeyPair *secp256k1.Keypair) (*transaction.TransactOpts, error) {
+	signer := SignerConfig()
+	if err := signer.Validate(); err != nil {
+		return nil, err
+	}
+	if !signer.IsRemote() {
+		return transaction.NewKeyedTransactor(senderKeyPair.PrivateKey()), nil
+	}
+	return remoteTransactOpts(signer)
+}
+
+func remoteTransactOpts(signer celoClient.SignerConfig) (*transaction.TransactOpts, error) {
+	address := viper.GetString(flags.SignerAddressFlagName)
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("invalid signer address %s", address)
+	}
+	return celoClient.NewTransactOpts(signer, common.HexToAddress(address))
+}
+
+// InitializeReplayProtection enables strict replay protection mode unless
+// --allow-unprotected is set
+func InitializeReplayProtection() {
+	transaction.SetStrictReplayProtection(!viper.GetBool(flags.AllowUnprotectedFlagName))
+}
+
+// InitializeWaitingClient wraps signingClient so that the revert reasons of failed
+// transactions are decoded. With --wait receipts are polled for at most
+// --wait-timeout and the status of sent transactions is printed.
+func InitializeWaitingClient(signingClient calls.ClientDispatcher, client *evmclient.EVMClient) (calls.ClientDispatcher, error) {
+	abis, err := transaction.BridgeABIs()
+	if err != nil {
+		return nil, err
+	}
+	timeout := flags.DefaultWaitTimeout
+	report := func(*celoClient.TxStatus) {}
+	if viper.GetBool(flags.WaitFlagName) {
+		timeout = viper.GetDuration(flags.WaitTimeoutFlagName)
+		report = func(s *celoClient.TxStatus) {
+			fmt.Println(s)
+		}
+	}
+	return celoClient.NewWaitingClient(signingClient, client, timeout, report, abis...), nil
+}
+
+// Initialize transactor which is used for contract calls
+// if --prepare flag value is set as true (from CLI) call data is outputted to stdout
+// which can be used for multisig contract calls
+func InitializeTransactor(
+	gasPrice *big.Int,
+	txFabric calls.TxFabric,
+	client *evmclient.EVMClient,
+	prepareFlag bool,
+) (transactor.Transactor, error) {
+	InitializeReplayProtection()
+	if prepareFlag {
+		return prepare.NewPrepareTransactor(), nil
+	}
+
+	signingClient, err := InitializeSigningClient(client)
+	if err != nil {
+		return nil, err
+	}
+	signingClient, err = InitializeWaitingClient(signingClient, client)
+	if err != nil {
+		return nil, err
+	}
+	gasPricer := evmgaspricer.NewLondonGasPriceClient(
+		client,
+		&evmgaspricer.GasPricerOpts{UpperLimitFeePerGas: gasPrice},
+	)
+	return signAndSend.NewSignAndSendTransactor(txFabric, gasPricer, signingClient), nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
 	"github.com/ChainSafe/chainbridge-celo-module/transaction"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/local"
@@ -27,6 +28,8 @@ var (
 )
 
 func LocalSetupCLI(cmd *cobra.Command, args []string) error {
+	initialize.InitializeReplayProtection()
+
 	// init client1
 	ethClient, err := evmclient.NewEVMClientFromParams(celoEndpoint1, local.EveKp.PrivateKey())
 	if err != nil {
@@ -0,0 +1,21 @@
+package sign
+
+import (
+	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+//flag vars
+var (
+	File string
+)
+
+//processed flag vars
+var (
+	TypedData apitypes.TypedData
+)
+
+// global flags
+var (
+	senderKeyPair *secp256k1.Keypair
+)
@@ -0,0 +1,88 @@
+package sign
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/spf13/cobra"
+)
+
+var SignTypedDataCeloCmd = &cobra.Command{
+	Use:   "sign-typed-data",
+	Short: "Sign EIP-712 typed data",
+	Long:  "The sign-typed-data command signs EIP-712 typed data read from a JSON file with the sender key or the configured remote signer",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		// fetch global flag values
+		_, _, _, senderKeyPair, _, err = flags.GlobalFlagValues(cmd)
+		if err != nil {
+			return fmt.Errorf("could not get global flags: %v", err)
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts, err := initialize.InitializeTransactOpts(senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return SignTypedDataCmd(cmd, args, opts)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateSignTypedDataFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessSignTypedDataFlags(cmd, args)
+	},
+}
+
+func init() {
+	BindSignTypedDataFlags(SignTypedDataCeloCmd)
+}
+
+func BindSignTypedDataFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&File, "file", "", "Path to the JSON file with the typed data")
+	_ = cmd.MarkFlagRequired("file")
+}
+
+func ValidateSignTypedDataFlags(cmd *cobra.Command, args []string) error {
+	if File == "" {
+		return fmt.Errorf("typed data file not provided")
+	}
+	return nil
+}
+
+func ProcessSignTypedDataFlags(cmd *cobra.Command, args []string) error {
+	data, err := ioutil.ReadFile(File)
+	if err != nil {
+		return err
+	}
+	TypedData = apitypes.TypedData{}
+	if err := json.Unmarshal(data, &TypedData); err != nil {
+		return fmt.Errorf("invalid typed data: %w", err)
+	}
+	return nil
+}
+
+func SignTypedDataCmd(cmd *cobra.Command, args []string, opts *transaction.TransactOpts) error {
+	hash, err := transaction.TypedDataHash(TypedData)
+	if err != nil {
+		return err
+	}
+	signature, err := opts.SignTypedData(TypedData)
+	if err != nil {
+		return err
+	}
+	fmt.Printf(`
+Signer: %s
+Digest: %s
+Signature: %s
+`, opts.From.Hex(), hash.Hex(), hexutil.Encode(signature))
+	return nil
+}
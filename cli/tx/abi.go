@@ -0,0 +1,216 @@
+package tx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// loadMethod returns the ABI holding the called method, either parsed from the
+// function signature sig or read from the ABI JSON file abiFile
+func loadMethod(sig, abiFile, method string) (abi.ABI, string, error) {
+	if sig != "" {
+		m, err := parseSignature(sig)
+		if err != nil {
+			return abi.ABI{}, "", err
+		}
+		return abi.ABI{Methods: map[string]abi.Method{m.Name: m}}, m.Name, nil
+	}
+	data, err := ioutil.ReadFile(abiFile)
+	if err != nil {
+		return abi.ABI{}, "", err
+	}
+	parsed, err := abi.JSON(strings.NewReader(string(data)))
+	if err != nil {
+		return abi.ABI{}, "", fmt.Errorf("invalid ABI: %w", err)
+	}
+	if _, ok := parsed.Methods[method]; !ok {
+		return abi.ABI{}, "", fmt.Errorf("method %s not found in ABI", method)
+	}
+	return parsed, method, nil
+}
+
+// parseSignature parses a function signature such as
+// "balanceOf(address) returns (uint256)". Parameter names and data locations, as
+// in "transfer(address to, uint256 amount)", are ignored. Tuple types are not supported.
+func parseSignature(sig string) (abi.Method, error) {
+	start := strings.Index(sig, "(")
+	end := strings.Index(sig, ")")
+	if start < 1 || end < start {
+		return abi.Method{}, fmt.Errorf("invalid function signature %s", sig)
+	}
+	outputSig := strings.TrimSpace(sig[end+1:])
+	if outputSig != "" {
+		if !strings.HasPrefix(outputSig, "returns") {
+			return abi.Method{}, fmt.Errorf("invalid function signature %s", sig)
+		}
+		outputSig = strings.TrimSpace(strings.TrimPrefix(outputSig, "returns"))
+	}
+	inputs, err := parseArguments(sig[start+1 : end])
+	if err != nil {
+		return abi.Method{}, err
+	}
+	var outputs abi.Arguments
+	if outputSig != "" {
+		if !strings.HasPrefix(outputSig, "(") || !strings.HasSuffix(outputSig, ")") {
+			return abi.Method{}, fmt.Errorf("invalid return types %s", outputSig)
+		}
+		outputs, err = parseArguments(outputSig[1 : len(outputSig)-1])
+		if err != nil {
+			return abi.Method{}, err
+		}
+	}
+	name := strings.TrimSpace(sig[:start])
+	return abi.NewMethod(name, name, abi.Function, "", false, true, inputs, outputs), nil
+}
+
+func parseArguments(types string) (abi.Arguments, error) {
+	var args abi.Arguments
+	if strings.TrimSpace(types) == "" {
+		return args, nil
+	}
+	for _, param := range strings.Split(types, ",") {
+		if strings.Contains(param, "(") {
+			return nil, fmt.Errorf("tuple types are not supported: %s", strings.TrimSpace(param))
+		}
+		t, err := parameterType(param)
+		if err != nil {
+			return nil, err
+		}
+		typ, err := abi.NewType(t, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type %s: %w", t, err)
+		}
+		args = append(args, abi.Argument{Type: typ})
+	}
+	return args, nil
+}
+
+// parameterType returns the type of a parameter declared as "type [location] [name]"
+func parameterType(param string) (string, error) {
+	fields := strings.Fields(param)
+	switch {
+	case len(fields) == 0:
+		return "", errors.New("missing parameter type")
+	case len(fields) > 3:
+		return "", fmt.Errorf("invalid parameter %s", strings.TrimSpace(param))
+	case len(fields) == 3 && !isDataLocation(fields[1]):
+		return "", fmt.Errorf("invalid data location %s", fields[1])
+	}
+	return fields[0], nil
+}
+
+func isDataLocation(word string) bool {
+	return word == "memory" || word == "calldata" || word == "storage"
+}
+
+// convertArgs converts the command line arguments to the Go types of the method inputs
+func convertArgs(inputs abi.Arguments, args []string) ([]interface{}, error) {
+	if len(inputs) != len(args) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(inputs), len(args))
+	}
+	values := make([]interface{}, len(args))
+	for i, input := range inputs {
+		v, err := convertArg(input.Type, args[i])
+		if err != nil {
+			return nil, fmt.Errorf("argument %d (%s): %w", i, input.Type.String(), err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func convertArg(t abi.Type, arg string) (interface{}, error) {
+	switch t.T {
+	case abi.AddressTy:
+		if !common.IsHexAddress(arg) {
+			return nil, fmt.Errorf("invalid address %s", arg)
+		}
+		return common.HexToAddress(arg), nil
+	case abi.UintTy, abi.IntTy:
+		n, ok := new(big.Int).SetString(arg, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %s", arg)
+		}
+		if !integerInRange(t, n) {
+			return nil, fmt.Errorf("%s out of range of %s", arg, t.String())
+		}
+		if t.GetType() == reflect.TypeOf(n) {
+			return n, nil
+		}
+		if t.T == abi.UintTy {
+			return reflect.ValueOf(n.Uint64()).Convert(t.GetType()).Interface(), nil
+		}
+		return reflect.ValueOf(n.Int64()).Convert(t.GetType()).Interface(), nil
+	case abi.BoolTy:
+		return strconv.ParseBool(arg)
+	case abi.StringTy:
+		return arg, nil
+	case abi.BytesTy:
+		return hexutil.Decode(arg)
+	case abi.FixedBytesTy:
+		b, err := hexutil.Decode(arg)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != t.Size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", t.Size, len(b))
+		}
+		v := reflect.New(t.GetType()).Elem()
+		reflect.Copy(v, reflect.ValueOf(b))
+		return v.Interface(), nil
+	case abi.SliceTy, abi.ArrayTy:
+		return convertListArg(t, arg)
+	default:
+		return nil, errors.New("unsupported type")
+	}
+}
+
+// integerInRange returns true if n fits into the uintN or intN type t
+func integerInRange(t abi.Type, n *big.Int) bool {
+	if t.T == abi.UintTy {
+		return n.Sign() >= 0 && n.BitLen() <= t.Size
+	}
+	// intN holds -2^(N-1) to 2^(N-1)-1
+	max := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+	min := new(big.Int).Neg(max)
+	return n.Cmp(min) >= 0 && n.Cmp(max) < 0
+}
+
+// convertListArg converts a JSON list such as [1,2] or ["0x01","0x02"]
+func convertListArg(t abi.Type, arg string) (interface{}, error) {
+	var elems []json.RawMessage
+	if err := json.Unmarshal([]byte(arg), &elems); err != nil {
+		return nil, fmt.Errorf("invalid list %s: %w", arg, err)
+	}
+	if t.T == abi.ArrayTy && len(elems) != t.Size {
+		return nil, fmt.Errorf("expected %d elements, got %d", t.Size, len(elems))
+	}
+	var v reflect.Value
+	if t.T == abi.SliceTy {
+		v = reflect.MakeSlice(t.GetType(), len(elems), len(elems))
+	} else {
+		v = reflect.New(t.GetType()).Elem()
+	}
+	for i, elem := range elems {
+		s := string(elem)
+		if unquoted, err := strconv.Unquote(s); err == nil {
+			s = unquoted
+		}
+		e, err := convertArg(*t.Elem, s)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		v.Index(i).Set(reflect.ValueOf(e))
+	}
+	return v.Interface(), nil
+}
@@ -0,0 +1,131 @@
+package tx
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type ABITestSuite struct {
+	suite.Suite
+}
+
+func TestRunABITestSuite(t *testing.T) {
+	suite.Run(t, new(ABITestSuite))
+}
+
+func (s *ABITestSuite) convert(typ string, arg string) (interface{}, error) {
+	t, err := abi.NewType(typ, "", nil)
+	s.Nil(err)
+	return convertArg(t, arg)
+}
+
+func (s *ABITestSuite) TestParseSignature() {
+	m, err := parseSignature("balanceOf(address) returns (uint256)")
+	s.Nil(err)
+	s.Equal("balanceOf", m.Name)
+	s.Equal("balanceOf(address)", m.Sig)
+	s.Len(m.Outputs, 1)
+
+	m, err = parseSignature("pause()")
+	s.Nil(err)
+	s.Empty(m.Inputs)
+
+	for _, sig := range []string{
+		"transfer(address to,uint256 amount) returns (bool success)",
+		"transfer(address to, uint256 amount) returns (bool)",
+		"transfer(address, uint256 amount) returns (bool)",
+		"transfer(address calldata to, uint256 amount) returns (bool)",
+	} {
+		m, err = parseSignature(sig)
+		s.Nil(err, sig)
+		s.Equal("transfer(address,uint256)", m.Sig, sig)
+		s.Len(m.Outputs, 1, sig)
+	}
+
+	for _, sig := range []string{"balanceOf", "(address)", "balanceOf(address) (uint256)", "balanceOf(address) returns uint256", "f(foo)", "f((uint8,bool))", "f(uint256,)", "f(address payable to extra)", "f(bytes big data)"} {
+		_, err := parseSignature(sig)
+		s.NotNil(err, sig)
+	}
+}
+
+func (s *ABITestSuite) TestConvertsIntegers() {
+	testcases := []struct {
+		typ   string
+		arg   string
+		value interface{}
+	}{
+		{"uint8", "255", uint8(255)},
+		{"uint64", "0xff", uint64(255)},
+		{"int8", "-128", int8(-128)},
+		{"int8", "127", int8(127)},
+		{"int32", "-5", int32(-5)},
+		{"uint24", "16777215", big.NewInt(16777215)},
+		{"int256", "-1", big.NewInt(-1)},
+	}
+	for _, tc := range testcases {
+		v, err := s.convert(tc.typ, tc.arg)
+		s.Nil(err, tc.typ+" "+tc.arg)
+		s.Equal(tc.value, v, tc.typ+" "+tc.arg)
+	}
+}
+
+func (s *ABITestSuite) TestRejectsOutOfRangeIntegers() {
+	testcases := []struct {
+		typ string
+		arg string
+	}{
+		{"uint8", "256"},
+		{"uint8", "-1"},
+		{"uint256", "-1"},
+		{"uint24", "16777216"},
+		{"int8", "300"},
+		{"int8", "128"},
+		{"int8", "-129"},
+		{"int64", "9223372036854775808"},
+		{"int256", "57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{"uint8", "ten"},
+	}
+	for _, tc := range testcases {
+		_, err := s.convert(tc.typ, tc.arg)
+		s.NotNil(err, tc.typ+" "+tc.arg)
+	}
+}
+
+func (s *ABITestSuite) TestConvertsBytes() {
+	v, err := s.convert("bytes4", "0x01020304")
+	s.Nil(err)
+	s.Equal([4]byte{1, 2, 3, 4}, v)
+
+	_, err = s.convert("bytes4", "0x010203")
+	s.NotNil(err)
+	_, err = s.convert("bytes32", "0x01")
+	s.NotNil(err)
+	_, err = s.convert("bytes", "01")
+	s.NotNil(err)
+}
+
+func (s *ABITestSuite) TestConvertsAddresses() {
+	v, err := s.convert("address", "0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+	s.Nil(err)
+	s.Equal(common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B"), v)
+
+	for _, arg := range []string{"0x1234", "62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88", "0xZZ877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B"} {
+		_, err := s.convert("address", arg)
+		s.NotNil(err, arg)
+	}
+}
+
+func (s *ABITestSuite) TestConvertsLists() {
+	v, err := s.convert("uint8[]", "[1,2]")
+	s.Nil(err)
+	s.Equal([]uint8{1, 2}, v)
+
+	_, err = s.convert("uint8[2]", "[1,2,3]")
+	s.NotNil(err)
+	_, err = s.convert("int8[]", "[1,300]")
+	s.NotNil(err)
+}
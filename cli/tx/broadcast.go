@@ -0,0 +1,104 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	celoFlags "github.com/ChainSafe/chainbridge-celo-module/cli/flags"
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var broadcastCmd = &cobra.Command{
+	Use:   "broadcast",
+	Short: "Broadcast a signed transaction",
+	Long:  "The broadcast subcommand submits a signed RLP encoded transaction to the network",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initialize.InitializeReplayProtection()
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return BroadcastCmd(cmd, args, c)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateBroadcastFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessBroadcastFlags(cmd, args)
+	},
+}
+
+func BindBroadcastFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&File, "file", "", "Path to the file with the hex encoded signed transaction")
+	cmd.Flags().StringVar(&Raw, "raw", "", "Hex encoded signed transaction")
+}
+
+func init() {
+	BindBroadcastFlags(broadcastCmd)
+}
+
+func ValidateBroadcastFlags(cmd *cobra.Command, args []string) error {
+	if File != "" && Raw != "" {
+		return errors.New("only file or raw should be set")
+	}
+	if File == "" && Raw == "" {
+		return errors.New("file or raw flag should be set")
+	}
+	return nil
+}
+
+func ProcessBroadcastFlags(cmd *cobra.Command, args []string) error {
+	raw := Raw
+	if File != "" {
+		data, err := ioutil.ReadFile(File)
+		if err != nil {
+			return err
+		}
+		raw = string(data)
+	}
+	var err error
+	RawTx, err = hexutil.Decode(strings.TrimSpace(raw))
+	if err != nil {
+		return fmt.Errorf("invalid signed transaction: %w", err)
+	}
+	return nil
+}
+
+func BroadcastCmd(cmd *cobra.Command, args []string, c *evmclient.EVMClient) error {
+	tx := new(transaction.CeloTransaction)
+	if err := rlp.DecodeBytes(RawTx, tx); err != nil {
+		return fmt.Errorf("invalid signed transaction: %w", err)
+	}
+	if !tx.Protected() && transaction.StrictReplayProtection() {
+		return &transaction.UnprotectedTxError{Op: "broadcast"}
+	}
+	err := c.SendRawTransaction(context.Background(), RawTx)
+	if err != nil {
+		return err
+	}
+	log.Info().Msgf("Transaction %s broadcast", tx.Hash().Hex())
+	if !viper.GetBool(celoFlags.WaitFlagName) {
+		return nil
+	}
+	waitingClient, err := initialize.InitializeWaitingClient(nil, c)
+	if err != nil {
+		return err
+	}
+	_, err = waitingClient.WaitAndReturnTxReceipt(tx.Hash())
+	return err
+}
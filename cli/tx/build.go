@@ -0,0 +1,166 @@
+package tx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var buildCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Build an unsigned transaction",
+	Long:  "The build subcommand builds an unsigned transaction with nonce, gas and gas price filled from the network and writes it as JSON",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return BuildCmd(cmd, args, c)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateBuildFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessBuildFlags(cmd, args)
+	},
+}
+
+func BindBuildFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&From, "from", "", "Address that will sign the transaction")
+	cmd.Flags().StringVar(&To, "to", "", "Recipient or contract address")
+	cmd.Flags().StringVar(&Data, "data", "", "Hex encoded calldata, e.g. the output of a command run with --prepare")
+	cmd.Flags().StringVar(&Value, "value", "0", "Amount of CELO to send in wei")
+	cmd.Flags().StringVar(&FeeCurrency, "fee-currency", "", "Address of the token gas is paid in, CELO if not set")
+	cmd.Flags().Uint64Var(&Gas, "gas", 0, "Gas limit of the transaction, estimated if not set")
+	cmd.Flags().StringVar(&Out, "out", "", "File the unsigned transaction is written to, stdout if not set")
+	flags.MarkFlagsAsRequired(cmd, "from", "to")
+}
+
+func init() {
+	BindBuildFlags(buildCmd)
+}
+
+func ValidateBuildFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(From) {
+		return fmt.Errorf("invalid from address %s", From)
+	}
+	if !common.IsHexAddress(To) {
+		return fmt.Errorf("invalid to address %s", To)
+	}
+	if FeeCurrency != "" && !common.IsHexAddress(FeeCurrency) {
+		return fmt.Errorf("invalid fee currency address %s", FeeCurrency)
+	}
+	return nil
+}
+
+func ProcessBuildFlags(cmd *cobra.Command, args []string) error {
+	var err error
+	FromAddr = common.HexToAddress(From)
+	ToAddr = common.HexToAddress(To)
+	FeeCurrencyAddr = nil
+	if FeeCurrency != "" {
+		feeCurrency := common.HexToAddress(FeeCurrency)
+		FeeCurrencyAddr = &feeCurrency
+	}
+	DataBytes = nil
+	if Data != "" {
+		DataBytes, err = hexutil.Decode(Data)
+		if err != nil {
+			return fmt.Errorf("invalid data: %w", err)
+		}
+	}
+	var ok bool
+	RealValue, ok = new(big.Int).SetString(Value, 10)
+	if !ok {
+		return fmt.Errorf("invalid value %s", Value)
+	}
+	return nil
+}
+
+func BuildCmd(cmd *cobra.Command, args []string, c *evmclient.EVMClient) error {
+	ctx := context.Background()
+	chainID, err := c.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.LatestBlock()
+	if err != nil {
+		return err
+	}
+	nonce, err := c.PendingNonceAt(ctx, FromAddr)
+	if err != nil {
+		return err
+	}
+	price, err := suggestGasPrice(ctx, c, FeeCurrencyAddr)
+	if err != nil {
+		return fmt.Errorf("failed getting gas price: %w", err)
+	}
+	gas := Gas
+	if gas == 0 {
+		gas, err = estimateGas(ctx, c)
+		if err != nil {
+			return fmt.Errorf("failed estimating gas: %w", err)
+		}
+	}
+
+	tx := transaction.NewFeeCurrencyTransaction(nonce, &ToAddr, RealValue, gas, price, FeeCurrencyAddr, DataBytes)
+	out, err := json.MarshalIndent(transaction.NewUnsignedTransaction(chainID, head, FromAddr, tx), "", "  ")
+	if err != nil {
+		return err
+	}
+	log.Info().Msgf("Built transaction with nonce %d and gas %d for %s", nonce, gas, FromAddr.Hex())
+	return writeOutput(Out, out)
+}
+
+// suggestGasPrice returns the gas price in feeCurrency. Celo nodes accept the fee
+// currency as an optional eth_gasPrice argument. The --gas-price upper limit is in
+// CELO wei, so it only caps the price when gas is paid in CELO.
+func suggestGasPrice(ctx context.Context, c *evmclient.EVMClient, feeCurrency *common.Address) (*big.Int, error) {
+	var price hexutil.Big
+	var err error
+	if feeCurrency == nil {
+		err = c.CallContext(ctx, &price, "eth_gasPrice")
+	} else {
+		err = c.CallContext(ctx, &price, "eth_gasPrice", feeCurrency)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if feeCurrency == nil && gasPrice != nil && price.ToInt().Cmp(gasPrice) > 0 {
+		return gasPrice, nil
+	}
+	return price.ToInt(), nil
+}
+
+func estimateGas(ctx context.Context, c *evmclient.EVMClient) (uint64, error) {
+	callArgs := map[string]interface{}{
+		"from":  FromAddr,
+		"to":    ToAddr,
+		"value": (*hexutil.Big)(RealValue),
+		"data":  hexutil.Bytes(DataBytes),
+	}
+	if FeeCurrencyAddr != nil {
+		callArgs["feeCurrency"] = FeeCurrencyAddr
+	}
+	var gas hexutil.Uint64
+	err := c.CallContext(ctx, &gas, "eth_estimateGas", callArgs)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(gas), nil
+}
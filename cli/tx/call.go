@@ -0,0 +1,118 @@
+package tx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var callCmd = &cobra.Command{
+	Use:   "call [args...]",
+	Short: "Call a read-only contract method",
+	Long:  "The call subcommand calls a contract method without sending a transaction and prints the decoded return values. The method is given by a function signature such as \"balanceOf(address) returns (uint256)\" or by an ABI file and a method name",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		contract := contracts.NewContract(ToAddr, ContractABI, nil, c, nil)
+		return CallCmd(cmd, args, &contract)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateContractCallFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessContractCallFlags(cmd, args)
+	},
+}
+
+// BindContractCallFlags binds the flags selecting the contract and method shared by call and send
+func BindContractCallFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&To, "to", "", "Contract address")
+	cmd.Flags().StringVar(&Sig, "sig", "", "Function signature, e.g. \"transfer(address,uint256)\"; return types are given with \"returns (uint256)\"")
+	cmd.Flags().StringVar(&ABIFile, "abi", "", "Path to the contract ABI JSON file, used instead of --sig")
+	cmd.Flags().StringVar(&Method, "method", "", "Name of the called method in the ABI file")
+	flags.MarkFlagsAsRequired(cmd, "to")
+}
+
+func BindCallFlags(cmd *cobra.Command) {
+	BindContractCallFlags(cmd)
+	cmd.Flags().BoolVar(&JSON, "json", false, "Print the return values as JSON")
+}
+
+func init() {
+	BindCallFlags(callCmd)
+}
+
+func ValidateContractCallFlags(cmd *cobra.Command, args []string) error {
+	if !common.IsHexAddress(To) {
+		return fmt.Errorf("invalid contract address %s", To)
+	}
+	if Sig != "" && ABIFile != "" {
+		return errors.New("only sig or abi should be set")
+	}
+	if Sig == "" && ABIFile == "" {
+		return errors.New("sig or abi flag should be set")
+	}
+	if ABIFile != "" && Method == "" {
+		return errors.New("method flag should be set with abi")
+	}
+	return nil
+}
+
+func ProcessContractCallFlags(cmd *cobra.Command, args []string) error {
+	var err error
+	ToAddr = common.HexToAddress(To)
+	ContractABI, MethodName, err = loadMethod(Sig, ABIFile, Method)
+	if err != nil {
+		return err
+	}
+	CallArgs, err = convertArgs(ContractABI.Methods[MethodName].Inputs, args)
+	return err
+}
+
+func CallCmd(cmd *cobra.Command, args []string, contract *contracts.Contract) error {
+	values, err := contract.CallContract(MethodName, CallArgs...)
+	if err != nil {
+		abis, abiErr := transaction.BridgeABIs()
+		if abiErr != nil {
+			return err
+		}
+		return transaction.WrapRevert(err, append([]abi.ABI{ContractABI}, abis...)...)
+	}
+	outputs := ContractABI.Methods[MethodName].Outputs
+	results := make([]transaction.CallArg, len(values))
+	for i, v := range values {
+		results[i] = transaction.CallArg{Name: outputs[i].Name, Type: outputs[i].Type.String(), Value: v}
+	}
+
+	if JSON {
+		out, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+	for _, r := range results {
+		if r.Name != "" {
+			fmt.Printf("%s %s: %s\n", r.Type, r.Name, r)
+		} else {
+			fmt.Printf("%s: %s\n", r.Type, r)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package tx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/spf13/cobra"
+)
+
+var decodeCmd = &cobra.Command{
+	Use:   "decode <hex>",
+	Short: "Decode a raw transaction",
+	Long:  "The decode subcommand decodes a hex encoded signed transaction and prints its fields, the recovered sender and the decoded bridge or handler call",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return DecodeCmd(cmd, args)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateDecodeFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessDecodeFlags(cmd, args)
+	},
+}
+
+func BindDecodeFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&JSON, "json", false, "Print the decoded transaction as JSON")
+}
+
+func init() {
+	BindDecodeFlags(decodeCmd)
+}
+
+func ValidateDecodeFlags(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("expected the hex encoded transaction as the only argument")
+	}
+	return nil
+}
+
+func ProcessDecodeFlags(cmd *cobra.Command, args []string) error {
+	var err error
+	RawTx, err = hexutil.Decode(strings.TrimSpace(args[0]))
+	if err != nil {
+		return fmt.Errorf("invalid transaction hex: %w", err)
+	}
+	return nil
+}
+
+// DecodedTx holds the fields of a decoded transaction
+type DecodedTx struct {
+	Hash                common.Hash       `json:"hash"`
+	Layout              string            `json:"layout"`
+	Nonce               uint64            `json:"nonce"`
+	GasPrice            *hexutil.Big      `json:"gasPrice"`
+	Gas                 uint64            `json:"gas"`
+	FeeCurrency         *common.Address   `json:"feeCurrency"`
+	GatewayFeeRecipient *common.Address   `json:"gatewayFeeRecipient"`
+	GatewayFee          *hexutil.Big      `json:"gatewayFee"`
+	To                  *common.Address   `json:"to"`
+	Value               *hexutil.Big      `json:"value"`
+	Input               hexutil.Bytes     `json:"input"`
+	V                   *hexutil.Big      `json:"v"`
+	R                   *hexutil.Big      `json:"r"`
+	S                   *hexutil.Big      `json:"s"`
+	ChainID             *hexutil.Big      `json:"chainId"`
+	Protected           bool              `json:"protected"`
+	From                *common.Address   `json:"from"`
+	SenderError         string            `json:"senderError,omitempty"`
+	Call                *transaction.Call `json:"call"`
+}
+
+func DecodeCmd(cmd *cobra.Command, args []string) error {
+	tx := new(transaction.CeloTransaction)
+	if err := rlp.DecodeBytes(RawTx, tx); err != nil {
+		return fmt.Errorf("invalid transaction: %w", err)
+	}
+	abis, err := transaction.BridgeABIs()
+	if err != nil {
+		return err
+	}
+	decoded := DecodeTransaction(tx, abis...)
+
+	if JSON {
+		out, err := json.MarshalIndent(decoded, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+	printDecodedTx(decoded)
+	return nil
+}
+
+// DecodeTransaction returns the fields of tx together with its sender and the call decoded with abis
+func DecodeTransaction(tx *transaction.CeloTransaction, abis ...abi.ABI) *DecodedTx {
+	v, r, s := tx.RawSignatureValues()
+	decoded := &DecodedTx{
+		Hash:                tx.Hash(),
+		Layout:              "celo",
+		Nonce:               tx.Nonce(),
+		GasPrice:            (*hexutil.Big)(tx.GasPrice()),
+		Gas:                 tx.Gas(),
+		FeeCurrency:         tx.FeeCurrency(),
+		GatewayFeeRecipient: tx.GatewayFeeRecipient(),
+		GatewayFee:          (*hexutil.Big)(tx.GatewayFee()),
+		To:                  tx.To(),
+		Value:               (*hexutil.Big)(tx.Value()),
+		Input:               tx.Data(),
+		V:                   (*hexutil.Big)(v),
+		R:                   (*hexutil.Big)(r),
+		S:                   (*hexutil.Big)(s),
+		ChainID:             (*hexutil.Big)(tx.ChainId()),
+		Protected:           tx.Protected(),
+	}
+	if tx.EthCompatible() {
+		decoded.Layout = "ethCompatible"
+	}
+
+	// the sender of unprotected transactions is shown in strict mode too, they
+	// are only inspected, neither signed nor accepted
+	from, err := transaction.InspectSender(tx)
+	if err != nil {
+		decoded.SenderError = err.Error()
+	} else {
+		decoded.From = &from
+	}
+
+	call, err := transaction.DecodeCall(tx.Data(), abis...)
+	if err == nil {
+		decoded.Call = call
+	}
+	return decoded
+}
+
+func printDecodedTx(tx *DecodedTx) {
+	fmt.Printf(`
+Hash: %s
+Layout: %s
+Nonce: %d
+Gas price: %s
+Gas: %d
+Fee currency: %s
+Gateway fee recipient: %s
+Gateway fee: %s
+To: %s
+Value: %s
+Input: %s
+V: %s
+R: %s
+S: %s
+Chain ID: %s
+Protected: %t
+`,
+		tx.Hash.Hex(), tx.Layout, tx.Nonce, tx.GasPrice.ToInt(), tx.Gas,
+		formatAddress(tx.FeeCurrency, "CELO"), formatAddress(tx.GatewayFeeRecipient, "none"), tx.GatewayFee.ToInt(),
+		formatAddress(tx.To, "contract creation"), tx.Value.ToInt(), tx.Input,
+		tx.V.ToInt(), tx.R.ToInt(), tx.S.ToInt(), tx.ChainID.ToInt(), tx.Protected,
+	)
+	if tx.From != nil {
+		fmt.Printf("From: %s\n", tx.From.Hex())
+	} else {
+		fmt.Printf("From: unknown (%s)\n", tx.SenderError)
+	}
+	if tx.Call == nil {
+		fmt.Println("Call: unknown")
+		return
+	}
+	fmt.Printf("Call: %s\n", tx.Call.Signature)
+	for _, arg := range tx.Call.Args {
+		fmt.Printf("  %s %s: %s\n", arg.Type, arg.Name, arg)
+	}
+}
+
+func formatAddress(addr *common.Address, fallback string) string {
+	if addr == nil {
+		return fallback
+	}
+	return addr.Hex()
+}
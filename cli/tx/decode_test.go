@@ -0,0 +1,55 @@
+package tx
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/suite"
+)
+
+type DecodeTestSuite struct {
+	suite.Suite
+}
+
+func TestRunDecodeTestSuite(t *testing.T) {
+	suite.Run(t, new(DecodeTestSuite))
+}
+
+func (s *DecodeTestSuite) TearDownTest() {
+	transaction.SetStrictReplayProtection(false)
+}
+
+func (s *DecodeTestSuite) decode(signer transaction.CeloSigner) (*DecodedTx, common.Address) {
+	key, _ := crypto.GenerateKey()
+	to := common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+	unsigned, _ := transaction.NewCeloTransaction(1, &to, big.NewInt(0), 21000, []*big.Int{big.NewInt(1)}, nil)
+	signed, err := transaction.SignTx(unsigned.(*transaction.CeloTransaction), signer, key)
+	s.Nil(err)
+	raw, err := rlp.EncodeToBytes(signed)
+	s.Nil(err)
+
+	transaction.SetStrictReplayProtection(true)
+	tx := new(transaction.CeloTransaction)
+	s.Nil(rlp.DecodeBytes(raw, tx))
+	return DecodeTransaction(tx), crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func (s *DecodeTestSuite) TestRecoversUnprotectedSenderInStrictMode() {
+	decoded, from := s.decode(transaction.HomesteadSigner{})
+
+	s.False(decoded.Protected)
+	s.Empty(decoded.SenderError)
+	s.Equal(&from, decoded.From)
+}
+
+func (s *DecodeTestSuite) TestRecoversProtectedSender() {
+	decoded, from := s.decode(transaction.NewEIP155Signer(big.NewInt(44787)))
+
+	s.True(decoded.Protected)
+	s.Equal(big.NewInt(44787), decoded.ChainID.ToInt())
+	s.Equal(&from, decoded.From)
+}
@@ -0,0 +1,56 @@
+package tx
+
+import (
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+//flag vars
+var (
+	From                string
+	To                  string
+	Data                string
+	Value               string
+	FeeCurrency         string
+	Gas                 uint64
+	File                string
+	Out                 string
+	Keystore            string
+	Password            string
+	Raw                 string
+	JSON                bool
+	Sig                 string
+	ABIFile             string
+	Method              string
+	GatewayFee          string
+	GatewayFeeRecipient string
+)
+
+//processed flag vars
+var (
+	FromAddr        common.Address
+	ToAddr          common.Address
+	FeeCurrencyAddr *common.Address
+	DataBytes       []byte
+	RealValue       *big.Int
+	UnsignedTx      *transaction.UnsignedTransaction
+	RawTx           []byte
+	ContractABI     abi.ABI
+	MethodName      string
+	CallArgs        []interface{}
+	FeeOpts         transaction.FeeOptions
+	TxHash          common.Hash
+)
+
+// global flags
+var (
+	url           string
+	gasLimit      uint64
+	gasPrice      *big.Int
+	senderKeyPair *secp256k1.Keypair
+	prepare       bool
+)
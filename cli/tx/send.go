@@ -0,0 +1,123 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var sendCmd = &cobra.Command{
+	Use:   "send [args...]",
+	Short: "Send a contract call as a Celo transaction",
+	Long:  "The send subcommand sends a contract call as a Celo transaction, optionally paying gas in a fee currency and a gateway fee. The method is given by a function signature such as \"transfer(address,uint256)\" or by an ABI file and a method name",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		t, err := initialize.InitializeTransactor(gasPrice, FeeOpts.TxFabric(), c, prepare)
+		if err != nil {
+			return err
+		}
+		contract := contracts.NewContract(ToAddr, ContractABI, nil, c, t)
+		return SendCmd(cmd, args, c, &contract)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateSendFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		return ProcessSendFlags(cmd, args)
+	},
+}
+
+func BindSendFlags(cmd *cobra.Command) {
+	BindContractCallFlags(cmd)
+	cmd.Flags().StringVar(&Value, "value", "0", "Amount of CELO to send in wei")
+	cmd.Flags().StringVar(&FeeCurrency, "fee-currency", "", "Address of the token gas is paid in, CELO if not set")
+	cmd.Flags().StringVar(&GatewayFee, "gateway-fee", "", "Gateway fee paid to the gateway fee recipient in wei")
+	cmd.Flags().StringVar(&GatewayFeeRecipient, "gateway-fee-recipient", "", "Address of the gateway fee recipient")
+}
+
+func init() {
+	BindSendFlags(sendCmd)
+}
+
+func ValidateSendFlags(cmd *cobra.Command, args []string) error {
+	err := ValidateContractCallFlags(cmd, args)
+	if err != nil {
+		return err
+	}
+	if FeeCurrency != "" && !common.IsHexAddress(FeeCurrency) {
+		return fmt.Errorf("invalid fee currency address %s", FeeCurrency)
+	}
+	if (GatewayFee == "") != (GatewayFeeRecipient == "") {
+		return errors.New("gateway-fee and gateway-fee-recipient should be set together")
+	}
+	if GatewayFeeRecipient != "" && !common.IsHexAddress(GatewayFeeRecipient) {
+		return fmt.Errorf("invalid gateway fee recipient address %s", GatewayFeeRecipient)
+	}
+	return nil
+}
+
+func ProcessSendFlags(cmd *cobra.Command, args []string) error {
+	err := ProcessContractCallFlags(cmd, args)
+	if err != nil {
+		return err
+	}
+	var ok bool
+	RealValue, ok = new(big.Int).SetString(Value, 10)
+	if !ok {
+		return fmt.Errorf("invalid value %s", Value)
+	}
+
+	FeeOpts.FeeCurrency = nil
+	if FeeCurrency != "" {
+		feeCurrency := common.HexToAddress(FeeCurrency)
+		FeeOpts.FeeCurrency = &feeCurrency
+	}
+	FeeOpts.GatewayFeeRecipient = nil
+	FeeOpts.GatewayFee = nil
+	if GatewayFee != "" {
+		recipient := common.HexToAddress(GatewayFeeRecipient)
+		FeeOpts.GatewayFeeRecipient = &recipient
+		FeeOpts.GatewayFee, ok = new(big.Int).SetString(GatewayFee, 10)
+		if !ok {
+			return fmt.Errorf("invalid gateway fee %s", GatewayFee)
+		}
+	}
+	return nil
+}
+
+func SendCmd(cmd *cobra.Command, args []string, c *evmclient.EVMClient, contract *contracts.Contract) error {
+	opts := transactor.TransactOptions{GasLimit: gasLimit, Value: RealValue}
+	// the gas price of the core gas pricer is in CELO, fee currency gas prices are queried from the node
+	if FeeOpts.FeeCurrency != nil && !prepare {
+		price, err := suggestGasPrice(context.Background(), c, FeeOpts.FeeCurrency)
+		if err != nil {
+			return fmt.Errorf("failed getting gas price: %w", err)
+		}
+		opts.GasPrice = price
+	}
+
+	h, err := contract.ExecuteTransaction(MethodName, opts, CallArgs...)
+	if err != nil {
+		log.Error().Err(err).Msgf("send %s error", MethodName)
+		return err
+	}
+	log.Info().Msgf("%s sent to %s in %s", MethodName, ToAddr.Hex(), h.Hex())
+	return nil
+}
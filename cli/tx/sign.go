@@ -0,0 +1,79 @@
+package tx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/ChainSafe/chainbridge-core/keystore"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var signCmd = &cobra.Command{
+	Use:   "sign",
+	Short: "Sign a transaction offline",
+	Long:  "The sign subcommand signs a transaction built with the build subcommand with a key from an encrypted keystore file. It does not connect to a node",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		initialize.InitializeReplayProtection()
+		f, err := os.Open(Keystore)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if Password == "" {
+			Password = string(keystore.GetPassword(fmt.Sprintf("Enter password for keystore %s:", Keystore)))
+		}
+		opts, err := transaction.NewTransactor(f, Password)
+		if err != nil {
+			return fmt.Errorf("failed decrypting keystore: %w", err)
+		}
+		return SignCmd(cmd, args, opts)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		return ProcessSignFlags(cmd, args)
+	},
+}
+
+func BindSignFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&File, "file", "", "Path to the unsigned transaction JSON")
+	cmd.Flags().StringVar(&Keystore, "keystore", "", "Path to the encrypted JSON keystore file")
+	cmd.Flags().StringVar(&Password, "password", "", "Password of the keystore file, prompted for if not set")
+	cmd.Flags().StringVar(&Out, "out", "", "File the signed transaction is written to, stdout if not set")
+	flags.MarkFlagsAsRequired(cmd, "file", "keystore")
+}
+
+func init() {
+	BindSignFlags(signCmd)
+}
+
+func ProcessSignFlags(cmd *cobra.Command, args []string) error {
+	data, err := ioutil.ReadFile(File)
+	if err != nil {
+		return err
+	}
+	UnsignedTx = new(transaction.UnsignedTransaction)
+	if err := json.Unmarshal(data, UnsignedTx); err != nil {
+		return fmt.Errorf("invalid unsigned transaction: %w", err)
+	}
+	return UnsignedTx.Validate()
+}
+
+func SignCmd(cmd *cobra.Command, args []string, opts *transaction.TransactOpts) error {
+	signedTx, err := UnsignedTx.Sign(opts)
+	if err != nil {
+		return err
+	}
+	raw, err := rlp.EncodeToBytes(signedTx)
+	if err != nil {
+		return err
+	}
+	log.Info().Msgf("Signed transaction %s of %s", signedTx.Hash().Hex(), opts.From.Hex())
+	return writeOutput(Out, []byte(hexutil.Encode(raw)))
+}
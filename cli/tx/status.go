@@ -0,0 +1,94 @@
+package tx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	celoFlags "github.com/ChainSafe/chainbridge-celo-module/cli/flags"
+	"github.com/ChainSafe/chainbridge-celo-module/cli/initialize"
+	celoClient "github.com/ChainSafe/chainbridge-celo-module/client"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status <hash>",
+	Short: "Get the status of a transaction",
+	Long:  "The status subcommand prints whether a transaction is pending, succeeded or reverted, together with its block, gas used, fee and revert reason. With --wait it polls until the transaction is included",
+	PreRun: func(cmd *cobra.Command, args []string) {
+		logger.LoggerMetadata(cmd.Name(), cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := initialize.InitializeClient(url, senderKeyPair)
+		if err != nil {
+			return err
+		}
+		return StatusCmd(cmd, args, c)
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		err := ValidateStatusFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+		ProcessStatusFlags(cmd, args)
+		return nil
+	},
+}
+
+func BindStatusFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&JSON, "json", false, "Print the status as JSON")
+}
+
+func init() {
+	BindStatusFlags(statusCmd)
+}
+
+func ValidateStatusFlags(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("expected the transaction hash as the only argument")
+	}
+	if b, err := hexutil.Decode(args[0]); err != nil || len(b) != common.HashLength {
+		return fmt.Errorf("invalid transaction hash %s", args[0])
+	}
+	return nil
+}
+
+func ProcessStatusFlags(cmd *cobra.Command, args []string) {
+	TxHash = common.HexToHash(args[0])
+}
+
+func StatusCmd(cmd *cobra.Command, args []string, c *evmclient.EVMClient) error {
+	abis, err := transaction.BridgeABIs()
+	if err != nil {
+		return err
+	}
+	var s *celoClient.TxStatus
+	if viper.GetBool(celoFlags.WaitFlagName) {
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration(celoFlags.WaitTimeoutFlagName))
+		defer cancel()
+		s, err = celoClient.WaitForTxStatus(ctx, c, TxHash, celoClient.DefaultPollInterval, abis...)
+	} else {
+		s, err = celoClient.GetTxStatus(context.Background(), c, TxHash, abis...)
+	}
+	if err != nil {
+		return err
+	}
+
+	if JSON {
+		out, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+	fmt.Println(s)
+	return nil
+}
@@ -0,0 +1,45 @@
+package tx
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/flags"
+	"github.com/spf13/cobra"
+)
+
+var TxCeloCmd = &cobra.Command{
+	Use:   "tx",
+	Short: "Set of commands for building, signing and broadcasting transactions separately",
+	Long:  "Set of commands for building, signing and broadcasting transactions separately, which allows keys to be kept on an offline machine",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		var err error
+		// fetch global flag values
+		url, gasLimit, gasPrice, senderKeyPair, prepare, err = flags.GlobalFlagValues(cmd)
+		if err != nil {
+			return fmt.Errorf("could not get global flags: %v", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	TxCeloCmd.AddCommand(
+		buildCmd,
+		signCmd,
+		broadcastCmd,
+		decodeCmd,
+		callCmd,
+		sendCmd,
+		statusCmd,
+	)
+}
+
+// writeOutput writes data to the file at path or to stdout if path is empty
+func writeOutput(path string, data []byte) error {
+	if path == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0600)
+}
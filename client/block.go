@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RPCCaller performs raw JSON-RPC calls
+type RPCCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// blockHeader is the part of a block header read by BlockTime. Celo headers
+// don't decode into types.Header, so blocks are read with a raw call.
+type blockHeader struct {
+	Timestamp hexutil.Uint64 `json:"timestamp"`
+}
+
+// BlockTime returns the timestamp of the block with the given number
+func BlockTime(ctx context.Context, c RPCCaller, number uint64) (uint64, error) {
+	var head *blockHeader
+	err := c.CallContext(ctx, &head, "eth_getBlockByNumber", hexutil.EncodeUint64(number), false)
+	if err != nil {
+		return 0, err
+	}
+	if head == nil {
+		return 0, fmt.Errorf("block %d not found", number)
+	}
+	return uint64(head.Timestamp), nil
+}
+
+// FindBlockByTime returns the last block up to latest whose timestamp is not
+// after timestamp, or 0 if there is none. Block times are assumed to increase
+// with block numbers, so blocks are searched by bisection.
+func FindBlockByTime(ctx context.Context, c RPCCaller, timestamp, latest uint64) (uint64, error) {
+	lo, hi := uint64(0), latest
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		t, err := BlockTime(ctx, c, mid)
+		if err != nil {
+			return 0, err
+		}
+		if t <= timestamp {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo, nil
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeCaller answers eth_getBlockByNumber with blocks timed by times, indexed by
+// block number, and null for later blocks
+type fakeCaller struct {
+	times []uint64
+	calls int
+}
+
+func (f *fakeCaller) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	f.calls++
+	if method != "eth_getBlockByNumber" {
+		return errors.New("method not found")
+	}
+	number, err := hexutil.DecodeUint64(args[0].(string))
+	if err != nil {
+		return err
+	}
+	raw := []byte("null")
+	if number < uint64(len(f.times)) {
+		raw, _ = json.Marshal(map[string]interface{}{"timestamp": hexutil.Uint64(f.times[number])})
+	}
+	return json.Unmarshal(raw, result)
+}
+
+type FindBlockByTimeTestSuite struct {
+	suite.Suite
+	caller *fakeCaller
+}
+
+func TestRunFindBlockByTimeTestSuite(t *testing.T) {
+	suite.Run(t, new(FindBlockByTimeTestSuite))
+}
+
+func (s *FindBlockByTimeTestSuite) SetupTest() {
+	// blocks every 5 seconds starting at 1000, with two blocks at 1020
+	s.caller = &fakeCaller{times: []uint64{1000, 1005, 1010, 1015, 1020, 1020, 1025, 1030, 1035, 1040}}
+}
+
+func (s *FindBlockByTimeTestSuite) TestBlockTime() {
+	t, err := BlockTime(context.Background(), s.caller, 3)
+	s.Nil(err)
+	s.Equal(uint64(1015), t)
+}
+
+func (s *FindBlockByTimeTestSuite) TestBlockTimeFailsOnUnknownBlock() {
+	_, err := BlockTime(context.Background(), s.caller, 10)
+	s.EqualError(err, "block 10 not found")
+}
+
+func (s *FindBlockByTimeTestSuite) TestFindsLastBlockAtOrBeforeTimestamp() {
+	for timestamp, expected := range map[uint64]uint64{
+		1000: 0,
+		1012: 2,
+		1015: 3,
+		1020: 5,
+		1039: 8,
+		1040: 9,
+		2000: 9,
+	} {
+		n, err := FindBlockByTime(context.Background(), s.caller, timestamp, 9)
+		s.Nil(err)
+		s.Equal(expected, n, "timestamp %d", timestamp)
+	}
+}
+
+func (s *FindBlockByTimeTestSuite) TestReturnsFirstBlockIfAllAreLater() {
+	n, err := FindBlockByTime(context.Background(), s.caller, 900, 9)
+	s.Nil(err)
+	s.Equal(uint64(0), n)
+}
+
+func (s *FindBlockByTimeTestSuite) TestSearchesUpToLatest() {
+	n, err := FindBlockByTime(context.Background(), s.caller, 2000, 4)
+	s.Nil(err)
+	s.Equal(uint64(4), n)
+	s.LessOrEqual(s.caller.calls, 3)
+}
+
+func (s *FindBlockByTimeTestSuite) TestReturnsCallErrors() {
+	_, err := FindBlockByTime(context.Background(), s.caller, 1000, 20)
+	s.EqualError(err, "block 10 not found")
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// CeloClient is an EVMClient that signs transactions through TransactOpts
+// instead of the private key the underlying EVMClient was created with.
+type CeloClient struct {
+	*evmclient.EVMClient
+	opts      *transaction.TransactOpts
+	send      transaction.SendFn
+	nonce     *big.Int
+	nonceLock sync.Mutex
+}
+
+// NewCeloClient wraps client so that transactions are sent from opts.From and signed with opts.Signer.
+func NewCeloClient(client *evmclient.EVMClient, opts *transaction.TransactOpts) *CeloClient {
+	c := &CeloClient{
+		EVMClient: client,
+		opts:      opts,
+	}
+	c.send = c.signAndSend
+	return c
+}
+
+// NewCeloClientFromParams creates a CeloClient connected to url without any private key in memory.
+func NewCeloClientFromParams(url string, opts *transaction.TransactOpts) (*CeloClient, error) {
+	// EVMClient requires a key, it is never used for signing as all
+	// signing methods are overridden by CeloClient
+	placeholderKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	c, err := evmclient.NewEVMClientFromParams(url, placeholderKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewCeloClient(c, opts), nil
+}
+
+// WrapSigner replaces the client SignerFn with the one returned by wrap, which
+// allows checks such as signing policies to be run before signing.
+func (c *CeloClient) WrapSigner(wrap func(transaction.SignerFn) transaction.SignerFn) {
+	c.opts.Signer = wrap(c.opts.Signer)
+}
+
+// WrapSender replaces the client SendFn with the one returned by wrap, which
+// allows the outcome of signing and sending a transaction to be accounted for.
+func (c *CeloClient) WrapSender(wrap func(transaction.SendFn) transaction.SendFn) {
+	c.send = wrap(c.send)
+}
+
+func (c *CeloClient) From() common.Address {
+	return c.opts.From
+}
+
+func (c *CeloClient) RelayerAddress() common.Address {
+	return c.opts.From
+}
+
+// SignAndSendTransaction signs tx with the configured SignerFn and sends it to the network.
+func (c *CeloClient) SignAndSendTransaction(ctx context.Context, tx evmclient.CommonTransaction) (common.Hash, error) {
+	celoTx, ok := tx.(*transaction.CeloTransaction)
+	if !ok {
+		return common.Hash{}, errors.New("celo client can only sign celo transactions")
+	}
+	id, err := c.ChainID(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	head, err := c.LatestBlock()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	signer := transaction.MakeCeloSigner(transaction.CeloChainConfigByID(id), head)
+	return c.send(ctx, signer, c.opts.From, celoTx)
+}
+
+func (c *CeloClient) signAndSend(ctx context.Context, signer transaction.CeloSigner, from common.Address, tx *transaction.CeloTransaction) (common.Hash, error) {
+	signedTx, err := c.opts.Signer(signer, from, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	rawTx, err := rlp.EncodeToBytes(signedTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	err = c.SendRawTransaction(ctx, rawTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return signedTx.Hash(), nil
+}
+
+func (c *CeloClient) LockNonce() {
+	c.nonceLock.Lock()
+}
+
+func (c *CeloClient) UnlockNonce() {
+	c.nonceLock.Unlock()
+}
+
+func (c *CeloClient) UnsafeNonce() (*big.Int, error) {
+	var err error
+	for i := 0; i <= 10; i++ {
+		if c.nonce == nil {
+			var nonce uint64
+			nonce, err = c.PendingNonceAt(context.Background(), c.opts.From)
+			if err != nil {
+				time.Sleep(1 * time.Second)
+				continue
+			}
+			c.nonce = big.NewInt(0).SetUint64(nonce)
+		}
+		return c.nonce, nil
+	}
+	return nil, err
+}
+
+func (c *CeloClient) UnsafeIncreaseNonce() error {
+	nonce, err := c.UnsafeNonce()
+	if err != nil {
+		return err
+	}
+	c.nonce = nonce.Add(nonce, big.NewInt(1))
+	return nil
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultLogsChunk is the number of blocks queried per eth_getLogs request by default
+const DefaultLogsChunk = 5000
+
+// ErrTooManyLogs is returned if a single block has more logs than the node returns per query
+var ErrTooManyLogs = errors.New("node refused to return logs of a single block")
+
+// FilterLogsInChunks queries the logs matching q between the blocks from and to, both
+// inclusive, in chunks of at most chunk blocks and passes each chunk's logs to handle in
+// block order. A chunk is halved if the node refuses to return that many logs and grows
+// back after successful queries.
+func FilterLogsInChunks(
+	ctx context.Context,
+	c ethereum.LogFilterer,
+	q ethereum.FilterQuery,
+	from, to, chunk uint64,
+	handle func([]types.Log) error,
+) error {
+	if chunk == 0 {
+		chunk = DefaultLogsChunk
+	}
+	size := chunk
+	for start := from; start <= to; {
+		end := start + size - 1
+		if end > to || end < start {
+			end = to
+		}
+		q.FromBlock = new(big.Int).SetUint64(start)
+		q.ToBlock = new(big.Int).SetUint64(end)
+		logs, err := c.FilterLogs(ctx, q)
+		if err != nil {
+			if !isTooManyLogsError(err) {
+				return err
+			}
+			if size == 1 {
+				return ErrTooManyLogs
+			}
+			size /= 2
+			log.Debug().Msgf("Too many logs in blocks %d-%d, retrying with %d blocks", start, end, size)
+			continue
+		}
+		if err := handle(logs); err != nil {
+			return err
+		}
+		if end == to {
+			return nil
+		}
+		start = end + 1
+		if size < chunk {
+			size *= 2
+			if size > chunk {
+				size = chunk
+			}
+		}
+	}
+	return nil
+}
+
+// isTooManyLogsError reports whether err is a node refusing a query for matching
+// too many logs or taking too long, which differs between node implementations
+func isTooManyLogsError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{"too many", "query returned more than", "limit exceeded", "response size", "timeout", "timed out"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
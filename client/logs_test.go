@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeFilterer returns one log per block and refuses queries spanning more than max blocks
+type fakeFilterer struct {
+	max     uint64
+	queries [][2]uint64
+}
+
+func (f *fakeFilterer) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	f.queries = append(f.queries, [2]uint64{from, to})
+	if to-from+1 > f.max {
+		return nil, errors.New("query returned more than 10000 results")
+	}
+	var logs []types.Log
+	for b := from; b <= to; b++ {
+		logs = append(logs, types.Log{BlockNumber: b})
+	}
+	return logs, nil
+}
+
+func (f *fakeFilterer) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return event.NewSubscription(func(quit <-chan struct{}) error { return nil }), nil
+}
+
+type FilterLogsInChunksTestSuite struct {
+	suite.Suite
+}
+
+func TestRunFilterLogsInChunksTestSuite(t *testing.T) {
+	suite.Run(t, new(FilterLogsInChunksTestSuite))
+}
+
+func (s *FilterLogsInChunksTestSuite) collect(f *fakeFilterer, from, to, chunk uint64) ([]uint64, error) {
+	var blocks []uint64
+	err := FilterLogsInChunks(context.Background(), f, ethereum.FilterQuery{}, from, to, chunk, func(logs []types.Log) error {
+		for _, l := range logs {
+			blocks = append(blocks, l.BlockNumber)
+		}
+		return nil
+	})
+	return blocks, err
+}
+
+func (s *FilterLogsInChunksTestSuite) TestQueriesWholeRangeInChunks() {
+	f := &fakeFilterer{max: 100}
+	blocks, err := s.collect(f, 10, 34, 10)
+	s.Nil(err)
+	s.Len(blocks, 25)
+	s.Equal(uint64(10), blocks[0])
+	s.Equal(uint64(34), blocks[24])
+	s.Equal([][2]uint64{{10, 19}, {20, 29}, {30, 34}}, f.queries)
+}
+
+func (s *FilterLogsInChunksTestSuite) TestHalvesChunkOnTooManyLogs() {
+	f := &fakeFilterer{max: 3}
+	blocks, err := s.collect(f, 0, 9, 8)
+	s.Nil(err)
+	s.Len(blocks, 10)
+	for i, b := range blocks {
+		s.Equal(uint64(i), b)
+	}
+	s.Equal([2]uint64{0, 7}, f.queries[0])
+	s.Equal([2]uint64{0, 3}, f.queries[1])
+	s.Equal([2]uint64{0, 1}, f.queries[2])
+}
+
+func (s *FilterLogsInChunksTestSuite) TestFailsOnSingleBlockOverLimit() {
+	f := &fakeFilterer{max: 0}
+	_, err := s.collect(f, 0, 9, 8)
+	s.Equal(ErrTooManyLogs, err)
+}
+
+func (s *FilterLogsInChunksTestSuite) TestReturnsOtherErrors() {
+	err := FilterLogsInChunks(context.Background(), &failingFilterer{}, ethereum.FilterQuery{}, 0, 9, 8, func([]types.Log) error { return nil })
+	s.EqualError(err, "connection refused")
+}
+
+type failingFilterer struct {
+	fakeFilterer
+}
+
+func (f *failingFilterer) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, errors.New("connection refused")
+}
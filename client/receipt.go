@@ -0,0 +1,263 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrTxNotFound is returned if the node knows no transaction with the requested hash
+	ErrTxNotFound = errors.New("transaction not found")
+
+	// DefaultPollInterval is the interval receipts are polled at while waiting for inclusion
+	DefaultPollInterval = 2 * time.Second
+)
+
+// rpcTransaction is a transaction as returned by eth_getTransactionByHash on Celo
+// nodes, which includes the fee currency and gateway fee fields
+type rpcTransaction struct {
+	Hash                common.Hash     `json:"hash"`
+	BlockNumber         *hexutil.Big    `json:"blockNumber"`
+	From                common.Address  `json:"from"`
+	To                  *common.Address `json:"to"`
+	Gas                 hexutil.Uint64  `json:"gas"`
+	GasPrice            *hexutil.Big    `json:"gasPrice"`
+	FeeCurrency         *common.Address `json:"feeCurrency"`
+	GatewayFeeRecipient *common.Address `json:"gatewayFeeRecipient"`
+	GatewayFee          *hexutil.Big    `json:"gatewayFee"`
+	Value               *hexutil.Big    `json:"value"`
+	Input               hexutil.Bytes   `json:"input"`
+}
+
+// TxStatusCaller performs the calls needed to get the status of transactions
+type TxStatusCaller interface {
+	RPCCaller
+	CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error)
+}
+
+// TxStatus is the inclusion status of a transaction together with the fees it was charged
+type TxStatus struct {
+	Hash                common.Hash     `json:"hash"`
+	From                common.Address  `json:"from"`
+	To                  *common.Address `json:"to"`
+	Pending             bool            `json:"pending"`
+	Success             bool            `json:"success"`
+	BlockNumber         *big.Int        `json:"blockNumber,omitempty"`
+	GasLimit            uint64          `json:"gasLimit"`
+	GasUsed             uint64          `json:"gasUsed"`
+	GasPrice            *big.Int        `json:"gasPrice"`
+	FeeCurrency         *common.Address `json:"feeCurrency"`
+	GatewayFeeRecipient *common.Address `json:"gatewayFeeRecipient,omitempty"`
+	GatewayFee          *big.Int        `json:"gatewayFee,omitempty"`
+	// Fee is gas used times gas price plus the gateway fee, in the fee currency
+	Fee          *big.Int       `json:"fee,omitempty"`
+	RevertReason string         `json:"revertReason,omitempty"`
+	Receipt      *types.Receipt `json:"-"`
+
+	tx *rpcTransaction
+}
+
+// GetTxStatus returns the status of the transaction with hash h. The revert
+// reason of failed transactions is recovered by replaying them and decoded with abis.
+func GetTxStatus(ctx context.Context, c TxStatusCaller, h common.Hash, abis ...abi.ABI) (*TxStatus, error) {
+	var tx *rpcTransaction
+	err := c.CallContext(ctx, &tx, "eth_getTransactionByHash", h)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, ErrTxNotFound
+	}
+	s := &TxStatus{
+		Hash:                tx.Hash,
+		From:                tx.From,
+		To:                  tx.To,
+		Pending:             tx.BlockNumber == nil,
+		GasLimit:            uint64(tx.Gas),
+		GasPrice:            tx.GasPrice.ToInt(),
+		FeeCurrency:         tx.FeeCurrency,
+		GatewayFeeRecipient: tx.GatewayFeeRecipient,
+		tx:                  tx,
+	}
+	if tx.GatewayFeeRecipient != nil && tx.GatewayFee != nil && tx.GatewayFee.ToInt().Sign() != 0 {
+		s.GatewayFee = tx.GatewayFee.ToInt()
+	}
+	if s.Pending {
+		return s, nil
+	}
+
+	var raw json.RawMessage
+	err = c.CallContext(ctx, &raw, "eth_getTransactionReceipt", h)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		// the transaction was included but the receipt isn't indexed yet
+		s.Pending = true
+		return s, nil
+	}
+	receipt := new(types.Receipt)
+	if err := json.Unmarshal(raw, receipt); err != nil {
+		return nil, err
+	}
+	// the price paid since the Espresso hardfork, which may be lower than the
+	// price cap of the transaction; receipts of older blocks don't include it
+	var fees struct {
+		EffectiveGasPrice *hexutil.Big `json:"effectiveGasPrice"`
+	}
+	if err := json.Unmarshal(raw, &fees); err != nil {
+		return nil, err
+	}
+	if fees.EffectiveGasPrice != nil {
+		s.GasPrice = fees.EffectiveGasPrice.ToInt()
+	}
+	s.Receipt = receipt
+	s.BlockNumber = receipt.BlockNumber
+	s.GasUsed = receipt.GasUsed
+	s.Success = receipt.Status == types.ReceiptStatusSuccessful
+	s.Fee = new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), s.GasPrice)
+	if s.GatewayFee != nil {
+		s.Fee.Add(s.Fee, s.GatewayFee)
+	}
+	if !s.Success {
+		s.RevertReason, err = RevertReason(ctx, c, s, abis...)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Failed getting revert reason of %s", h.Hex())
+		}
+	}
+	return s, nil
+}
+
+// WaitForTxStatus polls the status of the transaction with hash h until it is
+// included or ctx is done
+func WaitForTxStatus(ctx context.Context, c TxStatusCaller, h common.Hash, interval time.Duration, abis ...abi.ABI) (*TxStatus, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s, err := GetTxStatus(ctx, c, h, abis...)
+		switch {
+		case err == nil && !s.Pending:
+			return s, nil
+		case err != nil && !errors.Is(err, ErrTxNotFound):
+			log.Debug().Err(err).Msgf("Failed getting status of %s", h.Hex())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %s not included: %w", h.Hex(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// RevertReason replays the failed transaction s with eth_call from its sender with
+// its value and gas on the state of the block it was included in, and returns the
+// reason it reverted with decoded with abis. The replay runs after every transaction
+// of the block, so a revert caused by state that a later transaction of the block
+// changed may not be reproduced. No gas price is set, since it may be in a fee
+// currency, and eth_call skips the balance check without one.
+func RevertReason(ctx context.Context, c TxStatusCaller, s *TxStatus, abis ...abi.ABI) (string, error) {
+	if s.tx == nil || s.BlockNumber == nil {
+		return "", errors.New("transaction is not included")
+	}
+	msg := ethereum.CallMsg{
+		From:  s.tx.From,
+		To:    s.tx.To,
+		Gas:   uint64(s.tx.Gas),
+		Value: s.tx.Value.ToInt(),
+		Data:  s.tx.Input,
+	}
+	_, err := c.CallContract(ctx, calls.ToCallArg(msg), s.BlockNumber)
+	if err == nil {
+		return "", errors.New("replayed call did not revert")
+	}
+	reason, ok := transaction.RevertReason(err, abis...)
+	if !ok {
+		return "", err
+	}
+	return reason, nil
+}
+
+func (s *TxStatus) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Transaction %s", s.Hash.Hex())
+	switch {
+	case s.Pending:
+		b.WriteString(" is pending")
+		return b.String()
+	case s.Success:
+		b.WriteString(" succeeded")
+	default:
+		b.WriteString(" reverted")
+	}
+	fmt.Fprintf(&b, "\n  block:       %s", s.BlockNumber)
+	fmt.Fprintf(&b, "\n  gas used:    %d of %d", s.GasUsed, s.GasLimit)
+	fmt.Fprintf(&b, "\n  gas price:   %s", s.GasPrice)
+	feeCurrency := "CELO"
+	if s.FeeCurrency != nil {
+		feeCurrency = s.FeeCurrency.Hex()
+	}
+	fmt.Fprintf(&b, "\n  fee:         %s (%s)", s.Fee, feeCurrency)
+	if s.GatewayFee != nil {
+		fmt.Fprintf(&b, "\n  gateway fee: %s to %s", s.GatewayFee, s.GatewayFeeRecipient.Hex())
+	}
+	if !s.Success {
+		reason := s.RevertReason
+		if reason == "" {
+			reason = "unknown"
+		}
+		fmt.Fprintf(&b, "\n  reason:      %s", reason)
+	}
+	return b.String()
+}
+
+// WaitingClient waits for receipts of the transactions it sends with a timeout
+// and reports their status, including the fees charged and revert reasons
+type WaitingClient struct {
+	calls.ClientDispatcher
+	client  TxStatusCaller
+	timeout time.Duration
+	report  func(*TxStatus)
+	abis    []abi.ABI
+}
+
+// NewWaitingClient wraps dispatcher so that receipts are polled through client
+// for at most timeout and the status of every transaction is passed to report.
+// Revert reasons are decoded with abis.
+func NewWaitingClient(dispatcher calls.ClientDispatcher, client TxStatusCaller, timeout time.Duration, report func(*TxStatus), abis ...abi.ABI) *WaitingClient {
+	return &WaitingClient{
+		ClientDispatcher: dispatcher,
+		client:           client,
+		timeout:          timeout,
+		report:           report,
+		abis:             abis,
+	}
+}
+
+func (c *WaitingClient) WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	s, err := WaitForTxStatus(ctx, c.client, h, DefaultPollInterval, c.abis...)
+	if err != nil {
+		return nil, err
+	}
+	c.report(s)
+	if !s.Success {
+		return s.Receipt, fmt.Errorf("transaction %s failed: %w", h.Hex(), &transaction.RevertError{Reason: s.RevertReason})
+	}
+	return s.Receipt, nil
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeStatusCaller answers the transaction and receipt queries with tx and
+// receipt and fails every eth_call with revert, recording the block it replays at
+type fakeStatusCaller struct {
+	tx        map[string]interface{}
+	receipt   map[string]interface{}
+	revert    error
+	callArgs  map[string]interface{}
+	callBlock *big.Int
+}
+
+func (f *fakeStatusCaller) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	var v interface{}
+	switch method {
+	case "eth_getTransactionByHash":
+		v = f.tx
+	case "eth_getTransactionReceipt":
+		v = f.receipt
+	default:
+		return errors.New("method not found")
+	}
+	raw, _ := json.Marshal(v)
+	return json.Unmarshal(raw, result)
+}
+
+func (f *fakeStatusCaller) CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
+	f.callArgs = callArgs
+	f.callBlock = blockNumber
+	return nil, f.revert
+}
+
+type TxStatusTestSuite struct {
+	suite.Suite
+	caller *fakeStatusCaller
+}
+
+func TestRunTxStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(TxStatusTestSuite))
+}
+
+func (s *TxStatusTestSuite) SetupTest() {
+	s.caller = &fakeStatusCaller{
+		tx: map[string]interface{}{
+			"hash":        common.HexToHash("0x1"),
+			"blockNumber": "0x64",
+			"from":        common.HexToAddress("0xa"),
+			"to":          common.HexToAddress("0xb"),
+			"gas":         "0x30d40",
+			"gasPrice":    "0x5",
+			"value":       "0x7",
+			"input":       "0xdead",
+		},
+		receipt: map[string]interface{}{
+			"blockNumber":       "0x64",
+			"transactionHash":   common.HexToHash("0x1"),
+			"cumulativeGasUsed": "0x5208",
+			"gasUsed":           "0x5208",
+			"logsBloom":         hexutil.Bytes(make([]byte, 256)),
+			"logs":              []interface{}{},
+			"status":            "0x1",
+		},
+	}
+}
+
+func (s *TxStatusTestSuite) TestFeeUsesEffectiveGasPrice() {
+	s.caller.receipt["effectiveGasPrice"] = "0x3"
+
+	status, err := GetTxStatus(context.Background(), s.caller, common.HexToHash("0x1"))
+	s.Nil(err)
+	s.True(status.Success)
+	s.Equal(big.NewInt(3), status.GasPrice)
+	s.Equal(big.NewInt(21000*3), status.Fee)
+}
+
+func (s *TxStatusTestSuite) TestFeeFallsBackToTransactionGasPrice() {
+	status, err := GetTxStatus(context.Background(), s.caller, common.HexToHash("0x1"))
+	s.Nil(err)
+	s.Equal(big.NewInt(5), status.GasPrice)
+	s.Equal(big.NewInt(21000*5), status.Fee)
+}
+
+func (s *TxStatusTestSuite) TestMissingReceiptIsPending() {
+	s.caller.receipt = nil
+
+	status, err := GetTxStatus(context.Background(), s.caller, common.HexToHash("0x1"))
+	s.Nil(err)
+	s.True(status.Pending)
+}
+
+func (s *TxStatusTestSuite) TestRevertReasonReplaysAtInclusionBlock() {
+	s.caller.receipt["status"] = "0x0"
+	s.caller.revert = errors.New("execution reverted: bridge paused")
+	bridgeABI, err := abi.JSON(strings.NewReader(consts.BridgeABI))
+	s.Nil(err)
+
+	status, err := GetTxStatus(context.Background(), s.caller, common.HexToHash("0x1"), bridgeABI)
+	s.Nil(err)
+	s.False(status.Success)
+	s.Equal("bridge paused", status.RevertReason)
+	s.Equal(big.NewInt(100), s.caller.callBlock)
+	s.Equal(common.HexToAddress("0xa"), s.caller.callArgs["from"])
+	s.Equal("0x30d40", s.caller.callArgs["gas"].(hexutil.Uint64).String())
+	s.Equal("0x7", s.caller.callArgs["value"].(*hexutil.Big).String())
+	s.NotContains(s.caller.callArgs, "gasPrice")
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ChainSafe/chainbridge-core/keystore"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Supported signer backends
+const (
+	LocalSigner   = "local"
+	ClefSigner    = "clef"
+	TransitSigner = "transit"
+)
+
+// SignerTokenEnv is the environment variable the transit signer token is read
+// from if it isn't set in the configuration.
+const SignerTokenEnv = "CELO_SIGNER_TOKEN"
+
+// SignerConfig selects the backend used to sign transactions.
+type SignerConfig struct {
+	Type  string `mapstructure:"type"`
+	URL   string `mapstructure:"url"`
+	Key   string `mapstructure:"key"`
+	Token string `mapstructure:"token"`
+}
+
+func (c *SignerConfig) Validate() error {
+	switch c.Type {
+	case "", LocalSigner:
+		return nil
+	case ClefSigner:
+		if c.URL == "" {
+			return fmt.Errorf("required field signer.url empty for %s signer", c.Type)
+		}
+	case TransitSigner:
+		if c.URL == "" {
+			return fmt.Errorf("required field signer.url empty for %s signer", c.Type)
+		}
+		if c.Key == "" {
+			return fmt.Errorf("required field signer.key empty for %s signer", c.Type)
+		}
+	default:
+		return fmt.Errorf("unknown signer type %s", c.Type)
+	}
+	return nil
+}
+
+// IsRemote returns true if keys are held outside of the process.
+func (c *SignerConfig) IsRemote() bool {
+	return c.Type != "" && c.Type != LocalSigner
+}
+
+// NewTransactOpts creates TransactOpts that sign transactions of account with the configured remote backend.
+func NewTransactOpts(c SignerConfig, account common.Address) (*transaction.TransactOpts, error) {
+	switch c.Type {
+	case ClefSigner:
+		return transaction.NewRemoteTransactor(c.URL, account)
+	case TransitSigner:
+		token := c.Token
+		if token == "" {
+			token = os.Getenv(SignerTokenEnv)
+		}
+		return transaction.NewTransitTransactor(c.URL, c.Key, token, account), nil
+	default:
+		return nil, fmt.Errorf("signer type %s has no remote backend", c.Type)
+	}
+}
+
+// NewChainClient creates a client for the chain that signs with the local
+// keystore key or the configured remote signer.
+//
+// With a remote signer the chain 'from' field is used as the signing account.
+func NewChainClient(config *chain.EVMConfig, signer SignerConfig) (*CeloClient, error) {
+	generalConfig := config.GeneralChainConfig
+
+	var c *CeloClient
+	if signer.IsRemote() {
+		opts, err := NewTransactOpts(signer, common.HexToAddress(generalConfig.From))
+		if err != nil {
+			return nil, err
+		}
+		c, err = NewCeloClientFromParams(generalConfig.Endpoint, opts)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		kp, err := keystore.KeypairFromAddress(generalConfig.From, keystore.EthChain, generalConfig.KeystorePath, generalConfig.Insecure)
+		if err != nil {
+			return nil, err
+		}
+		key := kp.(*secp256k1.Keypair).PrivateKey()
+		evmClient, err := evmclient.NewEVMClientFromParams(generalConfig.Endpoint, key)
+		if err != nil {
+			return nil, err
+		}
+		c = NewCeloClient(evmClient, transaction.NewKeyedTransactor(key))
+	}
+	return c, nil
+}
@@ -0,0 +1,158 @@
+package celo
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-celo-module/client"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/mitchellh/mapstructure"
+)
+
+// CeloConfig extends EVMConfig with Celo module specific options
+type CeloConfig struct {
+	*chain.EVMConfig
+	Signer client.SignerConfig
+	Policy PolicyConfig
+	Budget BudgetConfig
+	// ChainID is the network chain ID the endpoint is expected to serve
+	// (0 = not checked)
+	ChainID uint64
+	// CheckChainID makes the chain refuse to start if the endpoint doesn't
+	// serve ChainID, which is otherwise only checked by the doctor command
+	CheckChainID bool
+	// GoldTokenResourceID is the resource native CELO is bridged with through
+	// the ERC20 handler (empty = native CELO is not bridged)
+	GoldTokenResourceID string
+	// ResourceIDs are the resources expected to be registered on the bridge,
+	// which are inspected by the bridge status and doctor commands
+	ResourceIDs []string
+	// NotRelayer is what happens when the key is not a relayer of the bridge,
+	// NotRelayerRefuse (default) or NotRelayerListen
+	NotRelayer string
+	// RelayerCheckInterval is how often the relayer role is re-checked in
+	// seconds (0 = DefaultRelayerCheckInterval)
+	RelayerCheckInterval uint64
+	// Observer makes the chain record the messages it would vote on instead of
+	// voting, without loading the key
+	Observer bool
+	// ObserverPath is the file observed messages are appended to
+	// (empty = DefaultObserverPath)
+	ObserverPath string
+	// AllowUnprotectedTransactions disables strict replay protection mode, which
+	// is global to the process and stays enabled unless every chain disables it
+	AllowUnprotectedTransactions bool
+}
+
+// PolicyConfig configures the signing policy guarding the relayer key.
+// Calls to voteProposal and executeProposal on the bridge are always allowed.
+type PolicyConfig struct {
+	Disabled      bool                `mapstructure:"disabled"`
+	MaxValue      string              `mapstructure:"maxValue"`
+	FeeCurrencies []string            `mapstructure:"feeCurrencies"`
+	AllowedCalls  map[string][]string `mapstructure:"allowedCalls"`
+}
+
+// BudgetConfig limits the CELO the relayer account may spend on transactions
+// within a rolling window. Amount is in wei, the window in seconds.
+type BudgetConfig struct {
+	Amount         string  `mapstructure:"amount"`
+	Window         uint64  `mapstructure:"window"`
+	AlertThreshold float64 `mapstructure:"alertThreshold"`
+}
+
+type RawCeloConfig struct {
+	Signer client.SignerConfig `mapstructure:"signer"`
+	Policy PolicyConfig        `mapstructure:"policy"`
+	Budget BudgetConfig        `mapstructure:"budget"`
+
+	ChainID                      uint64   `mapstructure:"chainId"`
+	CheckChainID                 bool     `mapstructure:"checkChainId"`
+	GoldTokenResourceID          string   `mapstructure:"goldTokenResourceId"`
+	ResourceIDs                  []string `mapstructure:"resourceIds"`
+	NotRelayer                   string   `mapstructure:"notRelayer"`
+	RelayerCheckInterval         uint64   `mapstructure:"relayerCheckInterval"`
+	Observer                     bool     `mapstructure:"observer"`
+	ObserverPath                 string   `mapstructure:"observerPath"`
+	AllowUnprotectedTransactions bool     `mapstructure:"allowUnprotectedTransactions"`
+}
+
+func (c *RawCeloConfig) Validate() error {
+	if err := c.Signer.Validate(); err != nil {
+		return err
+	}
+	if _, ok := new(big.Int).SetString(c.Policy.MaxValue, 10); c.Policy.MaxValue != "" && !ok {
+		return fmt.Errorf("invalid policy.maxValue %s", c.Policy.MaxValue)
+	}
+	if _, ok := new(big.Int).SetString(c.Budget.Amount, 10); c.Budget.Amount != "" && !ok {
+		return fmt.Errorf("invalid budget.amount %s", c.Budget.Amount)
+	}
+	if c.Budget.AlertThreshold < 0 || c.Budget.AlertThreshold > 1 {
+		return fmt.Errorf("budget.alertThreshold has to be between 0 and 1")
+	}
+	for _, feeCurrency := range c.Policy.FeeCurrencies {
+		if !common.IsHexAddress(feeCurrency) {
+			return fmt.Errorf("invalid policy.feeCurrencies address %s", feeCurrency)
+		}
+	}
+	if c.CheckChainID && c.ChainID == 0 {
+		return fmt.Errorf("checkChainId requires chainId")
+	}
+	if c.GoldTokenResourceID != "" && len(common.FromHex(c.GoldTokenResourceID)) != 32 {
+		return fmt.Errorf("invalid goldTokenResourceId %s", c.GoldTokenResourceID)
+	}
+	for _, resourceID := range c.ResourceIDs {
+		if len(common.FromHex(resourceID)) != 32 {
+			return fmt.Errorf("invalid resourceIds entry %s", resourceID)
+		}
+	}
+	switch c.NotRelayer {
+	case "", NotRelayerRefuse, NotRelayerListen:
+	default:
+		return fmt.Errorf("invalid notRelayer %s, has to be %s or %s", c.NotRelayer, NotRelayerRefuse, NotRelayerListen)
+	}
+	for contract := range c.Policy.AllowedCalls {
+		if !common.IsHexAddress(contract) {
+			return fmt.Errorf("invalid policy.allowedCalls address %s", contract)
+		}
+	}
+	return nil
+}
+
+// NewCeloConfig decodes and validates an instance of an CeloConfig from
+// raw chain config
+func NewCeloConfig(chainConfig map[string]interface{}) (*CeloConfig, error) {
+	evmConfig, err := chain.NewEVMConfig(chainConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var c RawCeloConfig
+	err = mapstructure.Decode(chainConfig, &c)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CeloConfig{
+		EVMConfig: evmConfig,
+		Signer:    c.Signer,
+		Policy:    c.Policy,
+		Budget:    c.Budget,
+
+		ChainID:                      c.ChainID,
+		CheckChainID:                 c.CheckChainID,
+		GoldTokenResourceID:          c.GoldTokenResourceID,
+		ResourceIDs:                  c.ResourceIDs,
+		NotRelayer:                   c.NotRelayer,
+		RelayerCheckInterval:         c.RelayerCheckInterval,
+		Observer:                     c.Observer,
+		ObserverPath:                 c.ObserverPath,
+		AllowUnprotectedTransactions: c.AllowUnprotectedTransactions,
+	}, nil
+}
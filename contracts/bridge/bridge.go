@@ -0,0 +1,175 @@
+package bridge
+
+import (
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// BridgeContract extends the core BridgeContract with calls that identify
+// proposals by their data hash instead of a full proposal and with the admin
+// calls and getters the core contract is missing
+type BridgeContract struct {
+	*bridge.BridgeContract
+}
+
+func NewBridgeContract(
+	client calls.ContractCallerDispatcher,
+	bridgeContractAddress common.Address,
+	transactor transactor.Transactor,
+) *BridgeContract {
+	return &BridgeContract{bridge.NewBridgeContract(client, bridgeContractAddress, transactor)}
+}
+
+// GetProposal returns the status of the proposal with dataHash
+func (c *BridgeContract) GetProposal(
+	originDomainID uint8,
+	depositNonce uint64,
+	dataHash common.Hash,
+) (message.ProposalStatus, error) {
+	log.Debug().Msgf("Getting proposal %d-%d with data hash %s", originDomainID, depositNonce, dataHash.Hex())
+	res, err := c.CallContract("getProposal", originDomainID, depositNonce, dataHash)
+	if err != nil {
+		return message.ProposalStatus{}, err
+	}
+	out := *abi.ConvertType(res[0], new(message.ProposalStatus)).(*message.ProposalStatus)
+	return out, nil
+}
+
+// CancelProposal cancels the proposal with dataHash once it expired
+func (c *BridgeContract) CancelProposal(
+	originDomainID uint8,
+	depositNonce uint64,
+	dataHash common.Hash,
+	opts transactor.TransactOptions,
+) (*common.Hash, error) {
+	log.Debug().Msgf("Canceling proposal %d-%d with data hash %s", originDomainID, depositNonce, dataHash.Hex())
+	return c.ExecuteTransaction(
+		"cancelProposal",
+		opts,
+		originDomainID, depositNonce, dataHash,
+	)
+}
+
+// RemoveRelayer revokes the relayer role of relayerAddr
+func (c *BridgeContract) RemoveRelayer(
+	relayerAddr common.Address,
+	opts transactor.TransactOptions,
+) (*common.Hash, error) {
+	log.Debug().Msgf("Removing relayer %s", relayerAddr.String())
+	return c.ExecuteTransaction(
+		"adminRemoveRelayer",
+		opts,
+		relayerAddr,
+	)
+}
+
+// ChangeFee sets the fee charged for deposits to newFee wei
+func (c *BridgeContract) ChangeFee(
+	newFee *big.Int,
+	opts transactor.TransactOptions,
+) (*common.Hash, error) {
+	log.Debug().Msgf("Changing fee to %s", newFee.String())
+	return c.ExecuteTransaction(
+		"adminChangeFee",
+		opts,
+		newFee,
+	)
+}
+
+// RenounceAdmin transfers the admin role from the sender to newAdmin
+func (c *BridgeContract) RenounceAdmin(
+	newAdmin common.Address,
+	opts transactor.TransactOptions,
+) (*common.Hash, error) {
+	log.Debug().Msgf("Renouncing admin role to %s", newAdmin.String())
+	return c.ExecuteTransaction(
+		"renounceAdmin",
+		opts,
+		newAdmin,
+	)
+}
+
+// DomainID returns the domain ID of the chain the bridge is deployed on
+func (c *BridgeContract) DomainID() (uint8, error) {
+	log.Debug().Msg("Getting domain ID")
+	res, err := c.CallContract("_domainID")
+	if err != nil {
+		return 0, err
+	}
+	out := *abi.ConvertType(res[0], new(uint8)).(*uint8)
+	return out, nil
+}
+
+// IsPaused returns whether deposits and proposal execution are paused
+func (c *BridgeContract) IsPaused() (bool, error) {
+	log.Debug().Msg("Getting paused state")
+	res, err := c.CallContract("paused")
+	if err != nil {
+		return false, err
+	}
+	out := *abi.ConvertType(res[0], new(bool)).(*bool)
+	return out, nil
+}
+
+// GetFee returns the fee charged for deposits in wei
+func (c *BridgeContract) GetFee() (*big.Int, error) {
+	log.Debug().Msg("Getting fee")
+	res, err := c.CallContract("_fee")
+	if err != nil {
+		return nil, err
+	}
+	out := abi.ConvertType(res[0], new(big.Int)).(*big.Int)
+	return out, nil
+}
+
+// GetDepositCount returns the number of deposits made to destinationDomainID
+func (c *BridgeContract) GetDepositCount(destinationDomainID uint8) (uint64, error) {
+	log.Debug().Msgf("Getting deposit count for domain %d", destinationDomainID)
+	res, err := c.CallContract("_depositCounts", destinationDomainID)
+	if err != nil {
+		return 0, err
+	}
+	out := *abi.ConvertType(res[0], new(uint64)).(*uint64)
+	return out, nil
+}
+
+// GetAdmins returns the accounts holding the admin role
+func (c *BridgeContract) GetAdmins() ([]common.Address, error) {
+	return c.getRoleMembers("DEFAULT_ADMIN_ROLE")
+}
+
+// GetRelayers returns the accounts holding the relayer role
+func (c *BridgeContract) GetRelayers() ([]common.Address, error) {
+	return c.getRoleMembers("RELAYER_ROLE")
+}
+
+// getRoleMembers returns the members of the role returned by the roleMethod constant getter
+func (c *BridgeContract) getRoleMembers(roleMethod string) ([]common.Address, error) {
+	log.Debug().Msgf("Getting %s members", roleMethod)
+	res, err := c.CallContract(roleMethod)
+	if err != nil {
+		return nil, err
+	}
+	role := *abi.ConvertType(res[0], new([32]byte)).(*[32]byte)
+	res, err = c.CallContract("getRoleMemberCount", role)
+	if err != nil {
+		return nil, err
+	}
+	count := abi.ConvertType(res[0], new(big.Int)).(*big.Int)
+	members := make([]common.Address, 0, count.Int64())
+	for i := int64(0); i < count.Int64(); i++ {
+		res, err = c.CallContract("getRoleMember", role, big.NewInt(i))
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, *abi.ConvertType(res[0], new(common.Address)).(*common.Address))
+	}
+	return members, nil
+}
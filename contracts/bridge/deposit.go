@@ -0,0 +1,71 @@
+package bridge
+
+import (
+	"math/big"
+)
+
+// Kinds of deposit data
+const (
+	DepositKindFungible    = "fungible"
+	DepositKindNonFungible = "nonfungible"
+	DepositKindGeneric     = "generic"
+	DepositKindUnknown     = "unknown"
+)
+
+// permitDataLength is the length of the deadline and signature appended to
+// the data of ERC20 permit deposits
+const permitDataLength = 128
+
+// DepositData is the decoded data of a Deposit event
+type DepositData struct {
+	Kind string
+	// Amount is the amount of fungible and the token ID of non fungible deposits
+	Amount    *big.Int
+	Recipient []byte
+	Metadata  []byte
+}
+
+// DecodeDepositData decodes the deposit data layouts of the ERC20 handler, with
+// and without permit, of the ERC721 handler and of the generic handler. A
+// Deposit event doesn't tell which handler the deposit went to, so the layout
+// is recognized by the lengths encoded in the data. Unrecognized data has the
+// unknown kind.
+func DecodeDepositData(data []byte) *DepositData {
+	if len(data) >= 64 {
+		recipientLen, ok := lengthAt(data, 32)
+		end := 64 + recipientLen
+		if ok && end <= uint64(len(data)) {
+			d := &DepositData{
+				Amount:    new(big.Int).SetBytes(data[:32]),
+				Recipient: data[64:end],
+			}
+			rest := uint64(len(data)) - end
+			if metadataLen, ok := lengthAt(data, end); ok && rest >= 32 && rest-32 == metadataLen {
+				d.Kind = DepositKindNonFungible
+				d.Metadata = data[end+32:]
+				return d
+			}
+			if rest == 0 || rest == permitDataLength {
+				d.Kind = DepositKindFungible
+				return d
+			}
+		}
+	}
+	if metadataLen, ok := lengthAt(data, 0); ok && uint64(len(data))-32 == metadataLen {
+		return &DepositData{Kind: DepositKindGeneric, Metadata: data[32:]}
+	}
+	return &DepositData{Kind: DepositKindUnknown}
+}
+
+// lengthAt reads the 32 byte length at offset, ok is false if there is none or
+// it doesn't fit into an uint64
+func lengthAt(data []byte, offset uint64) (uint64, bool) {
+	if offset+32 > uint64(len(data)) {
+		return 0, false
+	}
+	l := new(big.Int).SetBytes(data[offset : offset+32])
+	if !l.IsUint64() {
+		return 0, false
+	}
+	return l.Uint64(), true
+}
@@ -0,0 +1,61 @@
+package bridge
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/erc20permit"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/deposit"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type DepositDataTestSuite struct {
+	suite.Suite
+	recipient []byte
+}
+
+func TestRunDepositDataTestSuite(t *testing.T) {
+	suite.Run(t, new(DepositDataTestSuite))
+}
+
+func (s *DepositDataTestSuite) SetupTest() {
+	s.recipient = common.HexToAddress("0x829bd824b016326a401d083b33d092293333a830").Bytes()
+}
+
+func (s *DepositDataTestSuite) TestDecodesErc20Deposit() {
+	d := DecodeDepositData(deposit.ConstructErc20DepositData(s.recipient, big.NewInt(100)))
+	s.Equal(DepositKindFungible, d.Kind)
+	s.Equal(big.NewInt(100), d.Amount)
+	s.Equal(s.recipient, d.Recipient)
+}
+
+func (s *DepositDataTestSuite) TestDecodesErc20PermitDeposit() {
+	data := erc20permit.ConstructErc20PermitDepositData(s.recipient, big.NewInt(100), big.NewInt(1700000000), make([]byte, 65))
+	d := DecodeDepositData(data)
+	s.Equal(DepositKindFungible, d.Kind)
+	s.Equal(big.NewInt(100), d.Amount)
+	s.Equal(s.recipient, d.Recipient)
+}
+
+func (s *DepositDataTestSuite) TestDecodesErc721Deposit() {
+	d := DecodeDepositData(deposit.ConstructErc721DepositData(s.recipient, big.NewInt(7), []byte("metadata")))
+	s.Equal(DepositKindNonFungible, d.Kind)
+	s.Equal(big.NewInt(7), d.Amount)
+	s.Equal(s.recipient, d.Recipient)
+	s.Equal([]byte("metadata"), d.Metadata)
+
+	d = DecodeDepositData(deposit.ConstructErc721DepositData(s.recipient, big.NewInt(7), nil))
+	s.Equal(DepositKindNonFungible, d.Kind)
+}
+
+func (s *DepositDataTestSuite) TestDecodesGenericDeposit() {
+	d := DecodeDepositData(deposit.ConstructGenericDepositData([]byte{0x01, 0x02}))
+	s.Equal(DepositKindGeneric, d.Kind)
+	s.Equal([]byte{0x01, 0x02}, d.Metadata)
+}
+
+func (s *DepositDataTestSuite) TestRejectsUnknownData() {
+	s.Equal(DepositKindUnknown, DecodeDepositData([]byte{0x01}).Kind)
+	s.Equal(DepositKindUnknown, DecodeDepositData(make([]byte, 70)).Kind)
+}
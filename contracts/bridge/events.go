@@ -0,0 +1,104 @@
+package bridge
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	coreTypes "github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrUnknownEvent is returned if a log isn't a bridge event of the expected type
+	ErrUnknownEvent = errors.New("log is not a known bridge event")
+
+	bridgeABI, _ = abi.JSON(strings.NewReader(consts.BridgeABI))
+)
+
+// DepositEvent is a Deposit event emitted by the bridge
+type DepositEvent struct {
+	DestinationDomainID uint8
+	ResourceID          coreTypes.ResourceID
+	DepositNonce        uint64
+	User                common.Address
+	Data                []byte
+	HandlerResponse     []byte
+	BlockNumber         uint64
+	TxHash              common.Hash
+}
+
+// ProposalEvent is a ProposalEvent or a ProposalVote event emitted by the bridge
+type ProposalEvent struct {
+	OriginDomainID uint8
+	DepositNonce   uint64
+	Status         uint8
+	DataHash       common.Hash
+	Vote           bool
+	BlockNumber    uint64
+	TxHash         common.Hash
+}
+
+// UnpackDepositEvent unpacks l as a Deposit event
+func UnpackDepositEvent(l types.Log) (*DepositEvent, error) {
+	if len(l.Topics) != 2 || l.Topics[0] != util.Deposit.GetTopic() {
+		return nil, ErrUnknownEvent
+	}
+	var e struct {
+		DestinationDomainID uint8
+		ResourceID          [32]byte
+		DepositNonce        uint64
+		Data                []byte
+		HandlerResponse     []byte
+	}
+	if err := bridgeABI.UnpackIntoInterface(&e, "Deposit", l.Data); err != nil {
+		return nil, err
+	}
+	return &DepositEvent{
+		DestinationDomainID: e.DestinationDomainID,
+		ResourceID:          e.ResourceID,
+		DepositNonce:        e.DepositNonce,
+		User:                common.BytesToAddress(l.Topics[1].Bytes()),
+		Data:                e.Data,
+		HandlerResponse:     e.HandlerResponse,
+		BlockNumber:         l.BlockNumber,
+		TxHash:              l.TxHash,
+	}, nil
+}
+
+// UnpackProposalEvent unpacks l as a ProposalEvent or a ProposalVote event
+func UnpackProposalEvent(l types.Log) (*ProposalEvent, error) {
+	if len(l.Topics) != 1 {
+		return nil, ErrUnknownEvent
+	}
+	var name string
+	switch l.Topics[0] {
+	case util.ProposalEvent.GetTopic():
+		name = "ProposalEvent"
+	case util.ProposalVote.GetTopic():
+		name = "ProposalVote"
+	default:
+		return nil, ErrUnknownEvent
+	}
+	var e struct {
+		OriginDomainID uint8
+		DepositNonce   uint64
+		Status         uint8
+		DataHash       [32]byte
+	}
+	if err := bridgeABI.UnpackIntoInterface(&e, name, l.Data); err != nil {
+		return nil, err
+	}
+	return &ProposalEvent{
+		OriginDomainID: e.OriginDomainID,
+		DepositNonce:   e.DepositNonce,
+		Status:         e.Status,
+		DataHash:       e.DataHash,
+		Vote:           name == "ProposalVote",
+		BlockNumber:    l.BlockNumber,
+		TxHash:         l.TxHash,
+	}, nil
+}
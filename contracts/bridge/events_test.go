@@ -0,0 +1,61 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/util"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventsTestSuite struct {
+	suite.Suite
+}
+
+func TestRunEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(EventsTestSuite))
+}
+
+func (s *EventsTestSuite) TestUnpacksDepositEvent() {
+	user := common.HexToAddress("0x829bd824b016326a401d083b33d092293333a830")
+	resourceID := common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000100")
+	data, err := bridgeABI.Events["Deposit"].Inputs.NonIndexed().Pack(uint8(2), resourceID, uint64(7), []byte{0x01}, []byte{})
+	s.Nil(err)
+
+	e, err := UnpackDepositEvent(types.Log{
+		Topics:      []common.Hash{util.Deposit.GetTopic(), common.BytesToHash(user.Bytes())},
+		Data:        data,
+		BlockNumber: 10,
+		TxHash:      common.HexToHash("0x01"),
+	})
+	s.Nil(err)
+	s.Equal(uint8(2), e.DestinationDomainID)
+	s.Equal(resourceID.Bytes(), e.ResourceID[:])
+	s.Equal(uint64(7), e.DepositNonce)
+	s.Equal(user, e.User)
+	s.Equal([]byte{0x01}, e.Data)
+	s.Equal(uint64(10), e.BlockNumber)
+}
+
+func (s *EventsTestSuite) TestUnpacksProposalVote() {
+	dataHash := common.HexToHash("0x02")
+	data, err := bridgeABI.Events["ProposalVote"].Inputs.Pack(uint8(1), uint64(7), uint8(1), dataHash)
+	s.Nil(err)
+
+	e, err := UnpackProposalEvent(types.Log{Topics: []common.Hash{util.ProposalVote.GetTopic()}, Data: data})
+	s.Nil(err)
+	s.True(e.Vote)
+	s.Equal(uint8(1), e.OriginDomainID)
+	s.Equal(uint64(7), e.DepositNonce)
+	s.Equal(uint8(1), e.Status)
+	s.Equal(dataHash, e.DataHash)
+}
+
+func (s *EventsTestSuite) TestRejectsOtherEvents() {
+	_, err := UnpackProposalEvent(types.Log{Topics: []common.Hash{util.Deposit.GetTopic()}})
+	s.Equal(ErrUnknownEvent, err)
+
+	_, err = UnpackDepositEvent(types.Log{Topics: []common.Hash{util.ProposalEvent.GetTopic()}})
+	s.Equal(ErrUnknownEvent, err)
+}
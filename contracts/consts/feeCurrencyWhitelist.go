@@ -0,0 +1,4 @@
+package consts
+
+// FeeCurrencyWhitelistABI is the subset of the Celo FeeCurrencyWhitelist contract ABI used by the module
+const FeeCurrencyWhitelistABI = `[{"constant":true,"inputs":[],"name":"getWhitelist","outputs":[{"internalType":"address[]","name":"","type":"address[]"}],"payable":false,"stateMutability":"view","type":"function"}]`
@@ -0,0 +1,4 @@
+package consts
+
+// RegistryABI is the subset of the Celo Registry contract ABI used by the module
+const RegistryABI = `[{"constant":true,"inputs":[{"internalType":"string","name":"identifier","type":"string"}],"name":"getAddressForString","outputs":[{"internalType":"address","name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"internalType":"string","name":"identifier","type":"string"}],"name":"getAddressForStringOrDie","outputs":[{"internalType":"address","name":"","type":"address"}],"payable":false,"stateMutability":"view","type":"function"}]`
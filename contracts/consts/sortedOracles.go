@@ -0,0 +1,4 @@
+package consts
+
+// SortedOraclesABI is the subset of the Celo SortedOracles contract ABI used by the module
+const SortedOraclesABI = `[{"constant":true,"inputs":[{"internalType":"address","name":"token","type":"address"}],"name":"medianRate","outputs":[{"internalType":"uint256","name":"","type":"uint256"},{"internalType":"uint256","name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"}]`
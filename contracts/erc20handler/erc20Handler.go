@@ -0,0 +1,43 @@
+package erc20handler
+
+import (
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/erc20"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+type ERC20HandlerContract struct {
+	*erc20.ERC20HandlerContract
+}
+
+func NewERC20HandlerContract(
+	client calls.ContractCallerDispatcher,
+	erc20HandlerContractAddress common.Address,
+) *ERC20HandlerContract {
+	return &ERC20HandlerContract{erc20.NewERC20HandlerContract(client, erc20HandlerContractAddress, nil)}
+}
+
+// TokenAddress returns the address of the token registered for resourceID
+func (c *ERC20HandlerContract) TokenAddress(resourceID types.ResourceID) (common.Address, error) {
+	log.Debug().Msgf("Getting token address for resource %x", resourceID)
+	res, err := c.CallContract("_resourceIDToTokenContractAddress", resourceID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	out := *abi.ConvertType(res[0], new(common.Address)).(*common.Address)
+	return out, nil
+}
+
+// IsBurnable returns true if deposits of token are burned instead of locked in the handler
+func (c *ERC20HandlerContract) IsBurnable(token common.Address) (bool, error) {
+	log.Debug().Msgf("Getting burnable status of %s", token.String())
+	res, err := c.CallContract("_burnList", token)
+	if err != nil {
+		return false, err
+	}
+	out := *abi.ConvertType(res[0], new(bool)).(*bool)
+	return out, nil
+}
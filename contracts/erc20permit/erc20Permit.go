@@ -0,0 +1,160 @@
+package erc20permit
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/consts"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/deposit"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrPermitNotSupported is returned if the token doesn't implement EIP-2612 permits
+	ErrPermitNotSupported = errors.New("token does not support permit")
+
+	// permitVersions are the EIP-712 domain versions tried when matching the token domain separator
+	permitVersions = []string{"1", "2"}
+)
+
+type ERC20PermitContract struct {
+	contracts.Contract
+}
+
+func NewERC20PermitContract(
+	client calls.ContractCallerDispatcher,
+	erc20ContractAddress common.Address,
+	transactor transactor.Transactor,
+) *ERC20PermitContract {
+	a, _ := abi.JSON(strings.NewReader(consts.ERC20PermitABI))
+	return &ERC20PermitContract{contracts.NewContract(erc20ContractAddress, a, nil, client, transactor)}
+}
+
+func (c *ERC20PermitContract) Name() (string, error) {
+	res, err := c.CallContract("name")
+	if err != nil {
+		return "", err
+	}
+	return *abi.ConvertType(res[0], new(string)).(*string), nil
+}
+
+// Nonces returns the current permit nonce of owner
+func (c *ERC20PermitContract) Nonces(owner common.Address) (*big.Int, error) {
+	log.Debug().Msgf("Getting permit nonce of %s", owner.String())
+	res, err := c.CallContract("nonces", owner)
+	if err != nil {
+		return nil, err
+	}
+	return abi.ConvertType(res[0], new(big.Int)).(*big.Int), nil
+}
+
+func (c *ERC20PermitContract) DomainSeparator() (common.Hash, error) {
+	res, err := c.CallContract("DOMAIN_SEPARATOR")
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.Hash(*abi.ConvertType(res[0], new([32]byte)).(*[32]byte)), nil
+}
+
+func (c *ERC20PermitContract) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	res, err := c.CallContract("allowance", owner, spender)
+	if err != nil {
+		return nil, err
+	}
+	return abi.ConvertType(res[0], new(big.Int)).(*big.Int), nil
+}
+
+func (c *ERC20PermitContract) ApproveTokens(
+	target common.Address,
+	amount *big.Int,
+	opts transactor.TransactOptions,
+) (*common.Hash, error) {
+	log.Debug().Msgf("Approving %s tokens for %s", amount.String(), target.String())
+	return c.ExecuteTransaction("approve", opts, target, amount)
+}
+
+// PermitDomain returns the EIP-712 domain of the token permits on chainID.
+// ErrPermitNotSupported is returned if the token has no permit functions or
+// its domain separator doesn't match a standard EIP-2612 domain.
+func (c *ERC20PermitContract) PermitDomain(chainID *big.Int) (apitypes.TypedDataDomain, error) {
+	separator, err := c.DomainSeparator()
+	if err != nil {
+		return apitypes.TypedDataDomain{}, ErrPermitNotSupported
+	}
+	name, err := c.Name()
+	if err != nil {
+		return apitypes.TypedDataDomain{}, ErrPermitNotSupported
+	}
+	for _, version := range permitVersions {
+		domain := apitypes.TypedDataDomain{
+			Name:              name,
+			Version:           version,
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: c.ContractAddress().Hex(),
+		}
+		hash, err := transaction.DomainSeparator(apitypes.TypedData{Types: permitTypes, Domain: domain})
+		if err == nil && hash == separator {
+			return domain, nil
+		}
+	}
+	return apitypes.TypedDataDomain{}, ErrPermitNotSupported
+}
+
+var permitTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"Permit": {
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+}
+
+// PermitTypedData returns the EIP-2612 permit allowing spender to transfer value tokens of owner
+func PermitTypedData(
+	domain apitypes.TypedDataDomain,
+	owner common.Address,
+	spender common.Address,
+	value *big.Int,
+	nonce *big.Int,
+	deadline *big.Int,
+) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       permitTypes,
+		PrimaryType: "Permit",
+		Domain:      domain,
+		Message: apitypes.TypedDataMessage{
+			"owner":    owner.Hex(),
+			"spender":  spender.Hex(),
+			"value":    (*math.HexOrDecimal256)(value),
+			"nonce":    (*math.HexOrDecimal256)(nonce),
+			"deadline": (*math.HexOrDecimal256)(deadline),
+		},
+	}
+}
+
+// ConstructErc20PermitDepositData appends a permit to the ERC20 deposit data:
+// deadline(32) | v(32) | r(32) | s(32). A permit aware ERC20 handler redeems the
+// permit for the deposited amount before transferring the tokens.
+func ConstructErc20PermitDepositData(destRecipient []byte, amount *big.Int, deadline *big.Int, signature []byte) []byte {
+	data := deposit.ConstructErc20DepositData(destRecipient, amount)
+	data = append(data, math.PaddedBigBytes(deadline, 32)...)
+	data = append(data, math.PaddedBigBytes(big.NewInt(int64(signature[64])), 32)...)
+	data = append(data, signature[:64]...)
+	return data
+}
@@ -0,0 +1,48 @@
+package erc20permit
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/suite"
+)
+
+type PermitTestSuite struct {
+	suite.Suite
+}
+
+func TestRunPermitTestSuite(t *testing.T) {
+	suite.Run(t, new(PermitTestSuite))
+}
+
+func (s *PermitTestSuite) TestSignedPermitDepositData() {
+	key, _ := crypto.GenerateKey()
+	opts := transaction.NewKeyedTransactor(key)
+	domain := apitypes.TypedDataDomain{
+		Name:              "Celo Dollar",
+		Version:           "1",
+		ChainId:           math.NewHexOrDecimal256(44787),
+		VerifyingContract: "0x874069Fa1Eb16D44d622F2e0Ca25eeA172369bC1",
+	}
+	handler := common.HexToAddress("0xd606A00c1A39dA53EA7Bb3Ab570BBE40b156EB66")
+	permit := PermitTypedData(domain, opts.From, handler, big.NewInt(100), big.NewInt(0), big.NewInt(1700000000))
+
+	signature, err := opts.SignTypedData(permit)
+	s.Nil(err)
+	from, err := transaction.RecoverTypedData(permit, signature)
+	s.Nil(err)
+	s.Equal(opts.From, from)
+
+	recipient := common.HexToAddress("0x1")
+	data := ConstructErc20PermitDepositData(recipient.Bytes(), big.NewInt(100), big.NewInt(1700000000), signature)
+	s.Len(data, 64+common.AddressLength+4*32)
+	permitData := data[64+common.AddressLength:]
+	s.Equal(big.NewInt(1700000000), new(big.Int).SetBytes(permitData[:32]))
+	s.Equal(signature[64], permitData[63])
+	s.Equal(signature[:64], permitData[64:])
+}
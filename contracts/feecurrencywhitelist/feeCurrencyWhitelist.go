@@ -0,0 +1,35 @@
+package feecurrencywhitelist
+
+import (
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+type FeeCurrencyWhitelistContract struct {
+	contracts.Contract
+}
+
+func NewFeeCurrencyWhitelistContract(
+	client calls.ContractCallerDispatcher,
+	whitelistAddress common.Address,
+) *FeeCurrencyWhitelistContract {
+	a, _ := abi.JSON(strings.NewReader(consts.FeeCurrencyWhitelistABI))
+	return &FeeCurrencyWhitelistContract{contracts.NewContract(whitelistAddress, a, nil, client, nil)}
+}
+
+// GetWhitelist returns the addresses of the tokens gas can be paid in besides CELO
+func (c *FeeCurrencyWhitelistContract) GetWhitelist() ([]common.Address, error) {
+	log.Debug().Msg("Getting fee currency whitelist")
+	res, err := c.CallContract("getWhitelist")
+	if err != nil {
+		return nil, err
+	}
+	out := *abi.ConvertType(res[0], new([]common.Address)).(*[]common.Address)
+	return out, nil
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// HandlerContract reads the resource mappings of any bridge handler
+type HandlerContract struct {
+	contracts.Contract
+}
+
+func NewHandlerContract(
+	client calls.ContractCallerDispatcher,
+	handlerAddress common.Address,
+) *HandlerContract {
+	a, _ := abi.JSON(strings.NewReader(consts.HandlerABI))
+	return &HandlerContract{contracts.NewContract(handlerAddress, a, nil, client, nil)}
+}
+
+// ResourceContractAddress returns the contract registered for resourceID. Token
+// handlers are queried first, the generic handler mapping is used as fallback.
+func (c *HandlerContract) ResourceContractAddress(resourceID types.ResourceID) (common.Address, error) {
+	log.Debug().Msgf("Getting contract address for resource %x", resourceID)
+	res, err := c.CallContract("_resourceIDToTokenContractAddress", resourceID)
+	if err != nil {
+		res, err = c.CallContract("_resourceIDToContractAddress", resourceID)
+		if err != nil {
+			return common.Address{}, err
+		}
+	}
+	out := *abi.ConvertType(res[0], new(common.Address)).(*common.Address)
+	return out, nil
+}
@@ -0,0 +1,48 @@
+package registry
+
+import (
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// RegistryAddress is the address of the Celo Registry on every Celo network
+var RegistryAddress = common.HexToAddress("0x000000000000000000000000000000000000ce10")
+
+// Identifiers of core contracts in the Registry
+const (
+	GoldTokenID            = "GoldToken"
+	SortedOraclesID        = "SortedOracles"
+	FeeCurrencyWhitelistID = "FeeCurrencyWhitelist"
+	StableTokenID          = "StableToken"
+	StableTokenEURID       = "StableTokenEUR"
+	StableTokenBRLID       = "StableTokenBRL"
+)
+
+type RegistryContract struct {
+	contracts.Contract
+}
+
+func NewRegistryContract(
+	client calls.ContractCallerDispatcher,
+	registryAddress common.Address,
+) *RegistryContract {
+	a, _ := abi.JSON(strings.NewReader(consts.RegistryABI))
+	return &RegistryContract{contracts.NewContract(registryAddress, a, nil, client, nil)}
+}
+
+// GetAddressFor returns the address registered for identifier and fails if there is none
+func (c *RegistryContract) GetAddressFor(identifier string) (common.Address, error) {
+	log.Debug().Msgf("Getting registry address for %s", identifier)
+	res, err := c.CallContract("getAddressForStringOrDie", identifier)
+	if err != nil {
+		return common.Address{}, err
+	}
+	out := *abi.ConvertType(res[0], new(common.Address)).(*common.Address)
+	return out, nil
+}
@@ -0,0 +1,51 @@
+package sortedoracles
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+type SortedOraclesContract struct {
+	contracts.Contract
+}
+
+func NewSortedOraclesContract(
+	client calls.ContractCallerDispatcher,
+	sortedOraclesAddress common.Address,
+) *SortedOraclesContract {
+	a, _ := abi.JSON(strings.NewReader(consts.SortedOraclesABI))
+	return &SortedOraclesContract{contracts.NewContract(sortedOraclesAddress, a, nil, client, nil)}
+}
+
+// MedianRate returns the median rate of token as numerator/denominator token units per CELO
+func (c *SortedOraclesContract) MedianRate(token common.Address) (*big.Int, *big.Int, error) {
+	log.Debug().Msgf("Getting median rate for %s", token.String())
+	res, err := c.CallContract("medianRate", token)
+	if err != nil {
+		return nil, nil, err
+	}
+	numerator := abi.ConvertType(res[0], new(big.Int)).(*big.Int)
+	denominator := abi.ConvertType(res[1], new(big.Int)).(*big.Int)
+	return numerator, denominator, nil
+}
+
+// ToCelo converts an amount of token to CELO at the median rate
+func (c *SortedOraclesContract) ToCelo(token common.Address, amount *big.Int) (*big.Int, error) {
+	numerator, denominator, err := c.MedianRate(token)
+	if err != nil {
+		return nil, err
+	}
+	if numerator.Sign() == 0 {
+		return nil, errors.New("no oracle rate for " + token.String())
+	}
+	celo := new(big.Int).Mul(amount, denominator)
+	return celo.Div(celo, numerator), nil
+}
@@ -0,0 +1,52 @@
+package stabletoken
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+type StableTokenContract struct {
+	contracts.Contract
+}
+
+func NewStableTokenContract(
+	client calls.ContractCallerDispatcher,
+	tokenAddress common.Address,
+) *StableTokenContract {
+	a, _ := abi.JSON(strings.NewReader(consts.StableTokenABI))
+	return &StableTokenContract{contracts.NewContract(tokenAddress, a, nil, client, nil)}
+}
+
+func (c *StableTokenContract) Symbol() (string, error) {
+	log.Debug().Msgf("Getting symbol of %s", c.ContractAddress().String())
+	res, err := c.CallContract("symbol")
+	if err != nil {
+		return "", err
+	}
+	return *abi.ConvertType(res[0], new(string)).(*string), nil
+}
+
+func (c *StableTokenContract) Decimals() (uint8, error) {
+	log.Debug().Msgf("Getting decimals of %s", c.ContractAddress().String())
+	res, err := c.CallContract("decimals")
+	if err != nil {
+		return 0, err
+	}
+	return *abi.ConvertType(res[0], new(uint8)).(*uint8), nil
+}
+
+func (c *StableTokenContract) GetBalance(address common.Address) (*big.Int, error) {
+	log.Debug().Msgf("Getting balance for %s", address.String())
+	res, err := c.CallContract("balanceOf", address)
+	if err != nil {
+		return nil, err
+	}
+	return abi.ConvertType(res[0], new(big.Int)).(*big.Int), nil
+}
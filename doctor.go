@@ -0,0 +1,320 @@
+package celo
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	celoClient "github.com/ChainSafe/chainbridge-celo-module/client"
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/stabletoken"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	callsUtil "github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Statuses of a doctor check
+const (
+	CheckPass = "pass"
+	CheckFail = "fail"
+	CheckSkip = "skip"
+)
+
+// DefaultMaxBlockLag is the number of blocks the blockstore may be behind the
+// latest block before the doctor reports it, about 80 minutes of Celo blocks
+const DefaultMaxBlockLag = 1000
+
+// CheckResult is the outcome of a single doctor check. Hint tells how to fix a
+// failed or skipped check.
+type CheckResult struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Hint    string `json:"hint,omitempty"`
+}
+
+func (r CheckResult) Failed() bool {
+	return r.Status == CheckFail
+}
+
+// DoctorClient is the chain client the doctor checks read from
+type DoctorClient interface {
+	callsUtil.ContractCallerDispatcher
+	ChainIDReader
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	LatestBlock() (*big.Int, error)
+}
+
+// Doctor diagnoses the predictable reasons a relayer chain fails to relay: the
+// endpoint serving the wrong network, the key not being a relayer, a paused
+// bridge, an unfunded account, a stale blockstore and handler addresses that
+// don't match the bridge registrations.
+type Doctor struct {
+	config         *CeloConfig
+	blockstorePath string
+	maxBlockLag    uint64
+
+	client DoctorClient
+	bridge *celoBridge.BridgeContract
+	from   common.Address
+}
+
+// NewDoctor loads rawConfig the same way SetupCeloChain does. The relayer
+// key is never loaded, every check only reads the chain and the blockstore.
+func NewDoctor(rawConfig map[string]interface{}, blockstorePath string, maxBlockLag uint64) (*Doctor, error) {
+	config, err := NewCeloConfig(rawConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &Doctor{
+		config:         config,
+		blockstorePath: blockstorePath,
+		maxBlockLag:    maxBlockLag,
+		from:           common.HexToAddress(config.GeneralChainConfig.From),
+	}, nil
+}
+
+// Name returns the name of the diagnosed chain
+func (d *Doctor) Name() string {
+	return d.config.GeneralChainConfig.Name
+}
+
+// Run runs the checks in order. Nothing is checked after the endpoint turns
+// out to be unreachable.
+func (d *Doctor) Run() []CheckResult {
+	connection := d.checkConnection()
+	if connection.Failed() {
+		return []CheckResult{connection}
+	}
+	results := []CheckResult{
+		connection,
+		d.checkChainID(),
+		d.checkDomainID(),
+		d.checkRelayer(),
+		d.checkPaused(),
+		d.checkBalance(),
+		d.checkBlockstore(),
+		d.checkHandlers(),
+	}
+	if d.config.GoldTokenResourceID != "" {
+		results = append(results, d.checkGoldToken())
+	}
+	return results
+}
+
+func (d *Doctor) checkConnection() CheckResult {
+	r := CheckResult{Name: "connection"}
+	c, err := celoClient.NewCeloClientFromParams(d.config.GeneralChainConfig.Endpoint, &transaction.TransactOpts{From: d.from})
+	if err != nil {
+		return r.fail(fmt.Sprintf("failed connecting to %s: %s", d.config.GeneralChainConfig.Endpoint, err), "check that endpoint is the URL of a running and reachable node")
+	}
+	d.client = c
+	d.bridge = celoBridge.NewBridgeContract(c, common.HexToAddress(d.config.Bridge), nil)
+	return r.pass(fmt.Sprintf("connected to %s", d.config.GeneralChainConfig.Endpoint))
+}
+
+func (d *Doctor) checkChainID() CheckResult {
+	r := CheckResult{Name: "chain ID"}
+	if d.config.ChainID == 0 {
+		id, err := d.client.ChainID(context.Background())
+		if err != nil {
+			return r.fail(fmt.Sprintf("failed getting chain ID: %s", err), "check that endpoint is a Celo node")
+		}
+		return r.skip(fmt.Sprintf("chainId not configured, endpoint serves chain ID %s", id), "set chainId, and checkChainId to have the relayer refuse endpoints of other networks")
+	}
+	if err := CheckChainID(d.config, d.client); err != nil {
+		return r.fail(err.Error(), "point endpoint at a node of the intended network or fix chainId")
+	}
+	return r.pass(fmt.Sprintf("endpoint serves chain ID %d", d.config.ChainID))
+}
+
+func (d *Doctor) checkDomainID() CheckResult {
+	r := CheckResult{Name: "domain ID"}
+	domainID, err := d.bridge.DomainID()
+	if err != nil {
+		return r.fail(fmt.Sprintf("failed reading bridge %s: %s", d.config.Bridge, err), "check that bridge is the address of the bridge contract on this network")
+	}
+	if domainID != *d.config.GeneralChainConfig.Id {
+		return r.fail(fmt.Sprintf("bridge has domain ID %d, configured id is %d", domainID, *d.config.GeneralChainConfig.Id), fmt.Sprintf("set id to %d, the domain ID the bridges of the other chains deposit to", domainID))
+	}
+	return r.pass(fmt.Sprintf("bridge has domain ID %d", domainID))
+}
+
+func (d *Doctor) checkRelayer() CheckResult {
+	r := CheckResult{Name: "relayer"}
+	isRelayer, err := d.bridge.IsRelayer(d.from)
+	if err != nil {
+		return r.fail(fmt.Sprintf("failed checking relayer role: %s", err), "check that bridge is the address of the bridge contract on this network")
+	}
+	if !isRelayer {
+		return r.fail(fmt.Sprintf("%s is not a relayer of the bridge", d.from.Hex()), fmt.Sprintf("a bridge admin has to run: celo-cli admin add-relayer --bridge %s --relayer %s", d.config.Bridge, d.from.Hex()))
+	}
+	return r.pass(fmt.Sprintf("%s is a relayer of the bridge", d.from.Hex()))
+}
+
+func (d *Doctor) checkPaused() CheckResult {
+	r := CheckResult{Name: "paused"}
+	paused, err := d.bridge.IsPaused()
+	if err != nil {
+		return r.fail(fmt.Sprintf("failed checking paused state: %s", err), "check that bridge is the address of the bridge contract on this network")
+	}
+	if paused {
+		return r.fail("bridge is paused, proposals can't be voted on or executed", fmt.Sprintf("a bridge admin has to run: celo-cli admin unpause --bridge %s", d.config.Bridge))
+	}
+	return r.pass("bridge is not paused")
+}
+
+func (d *Doctor) checkBalance() CheckResult {
+	r := CheckResult{Name: "balance"}
+	hint := fmt.Sprintf("fund %s with CELO", d.from.Hex())
+	if len(d.config.Policy.FeeCurrencies) > 0 {
+		hint += " or one of policy.feeCurrencies"
+	}
+
+	celoBalance, err := d.client.BalanceAt(context.Background(), d.from, nil)
+	if err != nil {
+		return r.fail(fmt.Sprintf("failed getting CELO balance: %s", err), "check that endpoint is a Celo node")
+	}
+	funded := celoBalance.Sign() > 0
+	balances := []string{formatBalance(celoBalance, 18, "CELO")}
+	for _, feeCurrency := range d.config.Policy.FeeCurrencies {
+		token := stabletoken.NewStableTokenContract(d.client, common.HexToAddress(feeCurrency))
+		balance, err := token.GetBalance(d.from)
+		if err != nil {
+			return r.fail(fmt.Sprintf("failed getting balance of fee currency %s: %s", feeCurrency, err), "check that policy.feeCurrencies only lists stable token addresses of this network")
+		}
+		symbol, err := token.Symbol()
+		if err != nil {
+			symbol = feeCurrency
+		}
+		decimals, err := token.Decimals()
+		if err != nil {
+			decimals = 18
+		}
+		funded = funded || balance.Sign() > 0
+		balances = append(balances, formatBalance(balance, decimals, symbol))
+	}
+
+	message := fmt.Sprintf("%s has %s", d.from.Hex(), strings.Join(balances, ", "))
+	if !funded {
+		return r.fail(message+", votes can't be paid for", hint)
+	}
+	return r.pass(message)
+}
+
+func (d *Doctor) checkBlockstore() CheckResult {
+	r := CheckResult{Name: "blockstore"}
+	domainID := *d.config.GeneralChainConfig.Id
+	if _, err := os.Stat(d.blockstorePath); os.IsNotExist(err) {
+		return r.skip(fmt.Sprintf("no blockstore at %s, the relayer starts from startBlock", d.blockstorePath), "pass the blockstore path the relayer runs with")
+	}
+	db, err := lvldb.NewLvlDB(d.blockstorePath)
+	if err != nil {
+		return r.skip(fmt.Sprintf("failed opening blockstore %s: %s", d.blockstorePath, err), "the blockstore is locked while the relayer runs, stop it or run the doctor on a copy")
+	}
+	defer db.Close()
+
+	stored, err := store.NewBlockStore(db).GetLastStoredBlock(domainID)
+	if err != nil {
+		return r.fail(fmt.Sprintf("failed reading blockstore: %s", err), "check that the blockstore path belongs to this relayer")
+	}
+	if stored.Sign() == 0 {
+		return r.skip(fmt.Sprintf("no block stored for domain %d, the relayer starts from startBlock", domainID), "")
+	}
+	latest, err := d.client.LatestBlock()
+	if err != nil {
+		return r.fail(fmt.Sprintf("failed getting latest block: %s", err), "check that endpoint is a synced node")
+	}
+	lag := new(big.Int).Sub(latest, stored)
+	if lag.Cmp(new(big.Int).SetUint64(d.maxBlockLag)) > 0 {
+		return r.fail(
+			fmt.Sprintf("last stored block %s is %s blocks behind the latest block %s", stored, lag, latest),
+			"check that the relayer is running and its endpoint keeps up; starting with --latest skips the deposits of the missed blocks",
+		)
+	}
+	return r.pass(fmt.Sprintf("last stored block %s is %s blocks behind the latest block", stored, lag))
+}
+
+func (d *Doctor) checkHandlers() CheckResult {
+	r := CheckResult{Name: "handlers"}
+	configured := map[common.Address]string{}
+	for _, h := range []struct{ name, handler string }{
+		{"erc20Handler", d.config.Erc20Handler},
+		{"erc721Handler", d.config.Erc721Handler},
+		{"genericHandler", d.config.GenericHandler},
+	} {
+		name, handler := h.name, h.handler
+		if handler == "" {
+			continue
+		}
+		addr := common.HexToAddress(handler)
+		code, err := d.client.CodeAt(context.Background(), addr, nil)
+		if err != nil {
+			return r.fail(fmt.Sprintf("failed getting code of %s %s: %s", name, handler, err), "check that endpoint is a synced node")
+		}
+		if len(code) == 0 {
+			return r.fail(fmt.Sprintf("%s %s is not a contract", name, handler), fmt.Sprintf("set %s to the address of the handler deployed on this network", name))
+		}
+		configured[addr] = name
+	}
+
+	resourceIDs := append([]string{}, d.config.ResourceIDs...)
+	if d.config.GoldTokenResourceID != "" {
+		resourceIDs = append(resourceIDs, d.config.GoldTokenResourceID)
+	}
+	var mismatches []string
+	for _, id := range resourceIDs {
+		var resourceID types.ResourceID
+		copy(resourceID[:], common.FromHex(id))
+		handler, err := d.bridge.GetHandlerAddressForResourceID(resourceID)
+		if err != nil {
+			return r.fail(fmt.Sprintf("failed getting handler of resource %s: %s", id, err), "check that bridge is the address of the bridge contract on this network")
+		}
+		switch _, ok := configured[handler]; {
+		case handler == (common.Address{}):
+			mismatches = append(mismatches, fmt.Sprintf("resource %s is not registered", id))
+		case !ok:
+			mismatches = append(mismatches, fmt.Sprintf("resource %s is registered to handler %s, which is not configured", id, handler.Hex()))
+		}
+	}
+	if len(mismatches) > 0 {
+		return r.fail(strings.Join(mismatches, "; "), "set erc20Handler, erc721Handler and genericHandler to the handlers the bridge uses, or register the resources with celo-cli bridge register-resource")
+	}
+	if len(resourceIDs) == 0 {
+		return r.pass(fmt.Sprintf("%d configured handlers are deployed, no resourceIds configured to compare with the bridge registrations", len(configured)))
+	}
+	return r.pass(fmt.Sprintf("%d resources are registered to configured handlers", len(resourceIDs)))
+}
+
+func (d *Doctor) checkGoldToken() CheckResult {
+	r := CheckResult{Name: "native CELO"}
+	if err := CheckGoldTokenResource(d.config, d.client); err != nil {
+		return r.fail(err.Error(), fmt.Sprintf("register GoldToken with: celo-cli celo register-resource --bridge %s --handler %s --resource %s", d.config.Bridge, d.config.Erc20Handler, d.config.GoldTokenResourceID))
+	}
+	return r.pass(fmt.Sprintf("resource %s is registered for GoldToken", d.config.GoldTokenResourceID))
+}
+
+func (r CheckResult) pass(message string) CheckResult {
+	r.Status, r.Message = CheckPass, message
+	return r
+}
+
+func (r CheckResult) fail(message, hint string) CheckResult {
+	r.Status, r.Message, r.Hint = CheckFail, message, hint
+	return r
+}
+
+func (r CheckResult) skip(message, hint string) CheckResult {
+	r.Status, r.Message, r.Hint = CheckSkip, message, hint
+	return r
+}
+
+func formatBalance(amount *big.Int, decimals uint8, symbol string) string {
+	value, _ := callsUtil.WeiAmountToUser(amount, big.NewInt(int64(decimals)))
+	return fmt.Sprintf("%s %s", value.Text('f', -1), symbol)
+}
@@ -0,0 +1,160 @@
+package celo
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	celoBridge "github.com/ChainSafe/chainbridge-celo-module/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-celo-module/internal/calltest"
+	coreConsts "github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeChainClient is a calltest.ContractCaller that also serves the chain ID, the
+// latest block and the code of contracts
+type fakeChainClient struct {
+	*calltest.ContractCaller
+	chainID *big.Int
+	latest  *big.Int
+	code    map[common.Address][]byte
+}
+
+func (c *fakeChainClient) ChainID(ctx context.Context) (*big.Int, error) {
+	if c.chainID == nil {
+		return nil, errors.New("connection refused")
+	}
+	return c.chainID, nil
+}
+
+func (c *fakeChainClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+func (c *fakeChainClient) LatestBlock() (*big.Int, error) {
+	return c.latest, nil
+}
+
+func (c *fakeChainClient) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.code[contract], nil
+}
+
+type DoctorTestSuite struct {
+	suite.Suite
+	client       *fakeChainClient
+	doctor       *Doctor
+	bridge       common.Address
+	erc20Handler common.Address
+	resourceID   [32]byte
+	domainID     uint8
+}
+
+func TestRunDoctorTestSuite(t *testing.T) {
+	suite.Run(t, new(DoctorTestSuite))
+}
+
+func (s *DoctorTestSuite) SetupTest() {
+	s.bridge = common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+	s.erc20Handler = common.HexToAddress("0x3167776db165D8eA0f51790CA2bbf44Db5105ADF")
+	s.resourceID = [32]byte{31: 1}
+	s.domainID = 1
+	s.client = &fakeChainClient{
+		ContractCaller: calltest.NewContractCaller(),
+		chainID:        big.NewInt(44787),
+		latest:         big.NewInt(5000),
+		code:           map[common.Address][]byte{s.erc20Handler: {0x1}},
+	}
+	s.doctor = &Doctor{
+		config: &CeloConfig{
+			EVMConfig: &chain.EVMConfig{
+				GeneralChainConfig: chain.GeneralChainConfig{Id: &s.domainID, Endpoint: "http://localhost:8545"},
+				Bridge:             s.bridge.Hex(),
+				Erc20Handler:       s.erc20Handler.Hex(),
+			},
+			ChainID:     44787,
+			ResourceIDs: []string{common.Bytes2Hex(s.resourceID[:])},
+		},
+		blockstorePath: filepath.Join(s.T().TempDir(), "lvldbdata"),
+		maxBlockLag:    DefaultMaxBlockLag,
+		client:         s.client,
+		bridge:         celoBridge.NewBridgeContract(s.client, s.bridge, nil),
+	}
+}
+
+func (s *DoctorTestSuite) registerResource(handler common.Address) {
+	s.client.Respond(s.bridge, coreConsts.BridgeABI, "_resourceIDToHandlerAddress", handler)
+}
+
+func (s *DoctorTestSuite) storeBlock(block int64) {
+	db, err := lvldb.NewLvlDB(s.doctor.blockstorePath)
+	s.Nil(err)
+	s.Nil(store.NewBlockStore(db).StoreBlock(big.NewInt(block), s.domainID))
+	s.Nil(db.Close())
+}
+
+func (s *DoctorTestSuite) TestCheckChainID() {
+	s.Equal(CheckPass, s.doctor.checkChainID().Status)
+
+	s.client.chainID = big.NewInt(42220)
+	r := s.doctor.checkChainID()
+	s.Equal(CheckFail, r.Status)
+	s.Contains(r.Message, "serves chain ID 42220 instead of the configured 44787")
+
+	s.doctor.config.ChainID = 0
+	s.Equal(CheckSkip, s.doctor.checkChainID().Status)
+
+	s.client.chainID = nil
+	s.Equal(CheckFail, s.doctor.checkChainID().Status)
+}
+
+func (s *DoctorTestSuite) TestCheckHandlersPassesRegisteredResources() {
+	s.registerResource(s.erc20Handler)
+
+	s.Equal(CheckPass, s.doctor.checkHandlers().Status)
+}
+
+func (s *DoctorTestSuite) TestCheckHandlersFailsOnUnconfiguredHandler() {
+	other := common.HexToAddress("0x1")
+	s.registerResource(other)
+
+	r := s.doctor.checkHandlers()
+	s.Equal(CheckFail, r.Status)
+	s.Contains(r.Message, "registered to handler "+other.Hex()+", which is not configured")
+}
+
+func (s *DoctorTestSuite) TestCheckHandlersFailsOnUnregisteredResource() {
+	s.registerResource(common.Address{})
+
+	r := s.doctor.checkHandlers()
+	s.Equal(CheckFail, r.Status)
+	s.Contains(r.Message, "is not registered")
+}
+
+func (s *DoctorTestSuite) TestCheckHandlersFailsOnMissingContract() {
+	s.registerResource(s.erc20Handler)
+	delete(s.client.code, s.erc20Handler)
+
+	r := s.doctor.checkHandlers()
+	s.Equal(CheckFail, r.Status)
+	s.Contains(r.Message, "is not a contract")
+}
+
+func (s *DoctorTestSuite) TestCheckBlockstore() {
+	s.Equal(CheckSkip, s.doctor.checkBlockstore().Status)
+
+	s.storeBlock(4500)
+	r := s.doctor.checkBlockstore()
+	s.Equal(CheckPass, r.Status)
+	s.Contains(r.Message, "500 blocks behind")
+
+	s.storeBlock(3999)
+	r = s.doctor.checkBlockstore()
+	s.Equal(CheckFail, r.Status)
+	s.Contains(r.Message, "1001 blocks behind the latest block 5000")
+}
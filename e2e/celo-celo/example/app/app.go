@@ -38,7 +38,7 @@ func Run() error {
 		switch chainConfig["type"] {
 		case "celo":
 			{
-				chain, err := celo.SetupDefaultCeloChain(chainConfig, transaction.NewCeloTransaction, blockstore)
+				chain, err := celo.SetupCeloChain(chainConfig, transaction.NewCeloTransaction, blockstore)
 				if err != nil {
 					panic(err)
 				}
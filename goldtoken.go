@@ -0,0 +1,43 @@
+package celo
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/erc20handler"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/registry"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// CheckGoldTokenResource verifies the configured native CELO resource is
+// registered in the ERC20 handler for GoldToken. CELO can't be minted or
+// burned by the handler, so the resource must not be burnable: deposited CELO
+// is locked in the handler and released from it on executed proposals.
+func CheckGoldTokenResource(config *CeloConfig, client calls.ContractCallerDispatcher) error {
+	var resourceID types.ResourceID
+	copy(resourceID[:], common.FromHex(config.GoldTokenResourceID))
+
+	goldToken, err := registry.NewRegistryContract(client, registry.RegistryAddress).GetAddressFor(registry.GoldTokenID)
+	if err != nil {
+		return fmt.Errorf("failed resolving GoldToken address: %w", err)
+	}
+	handler := erc20handler.NewERC20HandlerContract(client, common.HexToAddress(config.Erc20Handler))
+	token, err := handler.TokenAddress(resourceID)
+	if err != nil {
+		return err
+	}
+	if token != goldToken {
+		return fmt.Errorf("resource %s is registered for %s instead of GoldToken %s", config.GoldTokenResourceID, token.Hex(), goldToken.Hex())
+	}
+	burnable, err := handler.IsBurnable(goldToken)
+	if err != nil {
+		return err
+	}
+	if burnable {
+		return fmt.Errorf("GoldToken %s is set burnable in the ERC20 handler, native CELO has to be locked", goldToken.Hex())
+	}
+	log.Info().Msgf("Native CELO is bridged with resource %s through GoldToken %s", config.GoldTokenResourceID, goldToken.Hex())
+	return nil
+}
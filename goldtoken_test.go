@@ -0,0 +1,61 @@
+package celo
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/consts"
+	"github.com/ChainSafe/chainbridge-celo-module/contracts/registry"
+	"github.com/ChainSafe/chainbridge-celo-module/internal/calltest"
+	coreConsts "github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type GoldTokenResourceTestSuite struct {
+	suite.Suite
+	caller    *calltest.ContractCaller
+	config    *CeloConfig
+	goldToken common.Address
+	handler   common.Address
+}
+
+func TestRunGoldTokenResourceTestSuite(t *testing.T) {
+	suite.Run(t, new(GoldTokenResourceTestSuite))
+}
+
+func (s *GoldTokenResourceTestSuite) SetupTest() {
+	s.goldToken = common.HexToAddress("0xF194afDf50B03e69Bd7D057c1Aa9e10c9954E4C9")
+	s.handler = common.HexToAddress("0x3167776db165D8eA0f51790CA2bbf44Db5105ADF")
+	s.config = &CeloConfig{
+		EVMConfig:           &chain.EVMConfig{Erc20Handler: s.handler.Hex()},
+		GoldTokenResourceID: "0x0000000000000000000000000000000000000000000000000000000000000100",
+	}
+	s.caller = calltest.NewContractCaller()
+	s.caller.Respond(registry.RegistryAddress, consts.RegistryABI, "getAddressForStringOrDie", s.goldToken)
+}
+
+func (s *GoldTokenResourceTestSuite) TestAcceptsLockedGoldToken() {
+	s.caller.Respond(s.handler, coreConsts.ERC20HandlerABI, "_resourceIDToTokenContractAddress", s.goldToken)
+	s.caller.Respond(s.handler, coreConsts.ERC20HandlerABI, "_burnList", false)
+
+	s.Nil(CheckGoldTokenResource(s.config, s.caller))
+}
+
+func (s *GoldTokenResourceTestSuite) TestRejectsOtherToken() {
+	s.caller.Respond(s.handler, coreConsts.ERC20HandlerABI, "_resourceIDToTokenContractAddress", common.HexToAddress("0x1"))
+	s.caller.Respond(s.handler, coreConsts.ERC20HandlerABI, "_burnList", false)
+
+	err := CheckGoldTokenResource(s.config, s.caller)
+	s.NotNil(err)
+	s.Contains(err.Error(), "instead of GoldToken")
+}
+
+func (s *GoldTokenResourceTestSuite) TestRejectsBurnableGoldToken() {
+	s.caller.Respond(s.handler, coreConsts.ERC20HandlerABI, "_resourceIDToTokenContractAddress", s.goldToken)
+	s.caller.Respond(s.handler, coreConsts.ERC20HandlerABI, "_burnList", true)
+
+	err := CheckGoldTokenResource(s.config, s.caller)
+	s.NotNil(err)
+	s.Contains(err.Error(), "burnable")
+}
@@ -0,0 +1,82 @@
+// Package calltest provides a fake contract caller for tests of the contract
+// bindings and the commands using them.
+package calltest
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ContractCaller answers contract calls with the output registered for the
+// called contract and method selector. Calls without a registered output revert.
+type ContractCaller struct {
+	outputs map[common.Address]map[string][]byte
+	calls   int
+}
+
+func NewContractCaller() *ContractCaller {
+	return &ContractCaller{outputs: make(map[common.Address]map[string][]byte)}
+}
+
+// Respond registers values as the output of calls to method of contract, packed
+// with the outputs of method in contractABI
+func (c *ContractCaller) Respond(contract common.Address, contractABI string, method string, values ...interface{}) {
+	a, err := abi.JSON(strings.NewReader(contractABI))
+	if err != nil {
+		panic(err)
+	}
+	output, err := a.Methods[method].Outputs.Pack(values...)
+	if err != nil {
+		panic(err)
+	}
+	if c.outputs[contract] == nil {
+		c.outputs[contract] = make(map[string][]byte)
+	}
+	c.outputs[contract][string(a.Methods[method].ID)] = output
+}
+
+// Calls returns the number of contract calls made
+func (c *ContractCaller) Calls() int {
+	return c.calls
+}
+
+func (c *ContractCaller) CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
+	c.calls++
+	to := callArgs["to"].(*common.Address)
+	data := callArgs["data"].(hexutil.Bytes)
+	output, ok := c.outputs[*to][string(data[:4])]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+	return output, nil
+}
+
+func (c *ContractCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (c *ContractCaller) WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *ContractCaller) SignAndSendTransaction(ctx context.Context, tx evmclient.CommonTransaction) (common.Hash, error) {
+	return common.Hash{}, errors.New("not supported")
+}
+
+func (c *ContractCaller) GetTransactionByHash(h common.Hash) (*types.Transaction, bool, error) {
+	return nil, false, errors.New("not supported")
+}
+
+func (c *ContractCaller) UnsafeNonce() (*big.Int, error) { return big.NewInt(0), nil }
+func (c *ContractCaller) LockNonce()                     {}
+func (c *ContractCaller) UnlockNonce()                   {}
+func (c *ContractCaller) UnsafeIncreaseNonce() error     { return nil }
+func (c *ContractCaller) From() common.Address           { return common.Address{} }
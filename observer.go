@@ -0,0 +1,135 @@
+package celo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultObserverPath is the file observed messages are appended to, formatted with the domain ID
+const DefaultObserverPath = "observed-%d.jsonl"
+
+// MessageHandler converts a message into the proposal voted on for it
+type MessageHandler interface {
+	HandleMessage(m *message.Message) (*proposal.Proposal, error)
+}
+
+// ProposalStatusReader returns the status of a proposal on the bridge
+type ProposalStatusReader interface {
+	ProposalStatus(p *proposal.Proposal) (message.ProposalStatus, error)
+}
+
+// ObservedMessage is a message an observer chain would have voted on
+type ObservedMessage struct {
+	ObservedAt   time.Time            `json:"observedAt"`
+	Source       uint8                `json:"source"`
+	Destination  uint8                `json:"destination"`
+	DepositNonce uint64               `json:"depositNonce"`
+	ResourceID   hexutil.Bytes        `json:"resourceId"`
+	Type         message.TransferType `json:"type"`
+	Handler      *common.Address      `json:"handler,omitempty"`
+	Data         hexutil.Bytes        `json:"data,omitempty"`
+	DataHash     *common.Hash         `json:"dataHash,omitempty"`
+	// Status is the status of the proposal on the bridge when the message was observed
+	Status string `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// MessageRecorder replaces the voter of observer chains. Instead of voting it
+// logs the proposal of each message and appends it to out as a JSON line, so
+// that it can be compared with the ProposalEvents of the real relayers by
+// source, deposit nonce and data hash.
+type MessageRecorder struct {
+	handler MessageHandler
+	bridge  ProposalStatusReader
+
+	lock   sync.Mutex
+	out    io.Writer
+	closed bool
+}
+
+func NewMessageRecorder(handler MessageHandler, bridge ProposalStatusReader, out io.Writer) *MessageRecorder {
+	return &MessageRecorder{
+		handler: handler,
+		bridge:  bridge,
+		out:     out,
+	}
+}
+
+func (r *MessageRecorder) VoteProposal(m *message.Message) error {
+	observed := ObservedMessage{
+		ObservedAt:   time.Now().UTC(),
+		Source:       m.Source,
+		Destination:  m.Destination,
+		DepositNonce: m.DepositNonce,
+		ResourceID:   m.ResourceId[:],
+		Type:         m.Type,
+	}
+	// failures are recorded rather than returned, the real relayers would have
+	// failed on the same message
+	p, err := r.handler.HandleMessage(m)
+	if err != nil {
+		observed.Error = err.Error()
+	} else {
+		dataHash := p.GetDataHash()
+		observed.Handler = &p.HandlerAddress
+		observed.Data = p.Data
+		observed.DataHash = &dataHash
+
+		status, err := r.bridge.ProposalStatus(p)
+		if err != nil {
+			observed.Error = fmt.Sprintf("failed getting proposal status: %s", err)
+		} else {
+			observed.Status = message.StatusMap[status.Status]
+		}
+	}
+
+	logger := log.Info()
+	if observed.Error != "" {
+		logger = log.Warn().Str("error", observed.Error)
+	}
+	logger.Uint8("source", m.Source).Uint64("nonce", m.DepositNonce).Str("status", observed.Status).Msg("Observed proposal")
+
+	line, err := json.Marshal(observed)
+	if err != nil {
+		return err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed {
+		return errors.New("message recorder is closed")
+	}
+	_, err = r.out.Write(append(line, '\n'))
+	return err
+}
+
+// Close flushes out to disk and closes it, if out is a file or another closer.
+// out is closed even if flushing fails, and the first error is returned.
+// Messages can't be recorded after Close.
+func (r *MessageRecorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	var err error
+	if syncer, ok := r.out.(interface{ Sync() error }); ok {
+		err = syncer.Sync()
+	}
+	if closer, ok := r.out.(io.Closer); ok {
+		if closeErr := closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
@@ -0,0 +1,120 @@
+package celo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeMessageHandler struct {
+	err error
+}
+
+func (h *fakeMessageHandler) HandleMessage(m *message.Message) (*proposal.Proposal, error) {
+	if h.err != nil {
+		return nil, h.err
+	}
+	return proposal.NewProposal(m.Source, m.DepositNonce, m.ResourceId, []byte{0x01}, common.HexToAddress("0x02"), common.HexToAddress("0x03")), nil
+}
+
+type fakeStatusReader struct{}
+
+func (r *fakeStatusReader) ProposalStatus(p *proposal.Proposal) (message.ProposalStatus, error) {
+	return message.ProposalStatus{Status: message.ProposalStatusExecuted}, nil
+}
+
+type MessageRecorderTestSuite struct {
+	suite.Suite
+	handler *fakeMessageHandler
+	out     *bytes.Buffer
+	rec     *MessageRecorder
+}
+
+func TestRunMessageRecorderTestSuite(t *testing.T) {
+	suite.Run(t, new(MessageRecorderTestSuite))
+}
+
+func (s *MessageRecorderTestSuite) SetupTest() {
+	s.handler = &fakeMessageHandler{}
+	s.out = &bytes.Buffer{}
+	s.rec = NewMessageRecorder(s.handler, &fakeStatusReader{}, s.out)
+}
+
+func (s *MessageRecorderTestSuite) TestRecordsProposal() {
+	m := &message.Message{Source: 1, Destination: 2, DepositNonce: 7, Type: message.FungibleTransfer}
+	s.Nil(s.rec.VoteProposal(m))
+	s.Nil(s.rec.VoteProposal(m))
+
+	lines := bytes.Split(bytes.TrimSpace(s.out.Bytes()), []byte("\n"))
+	s.Len(lines, 2)
+	var observed ObservedMessage
+	s.Nil(json.Unmarshal(lines[0], &observed))
+	s.Equal(uint8(1), observed.Source)
+	s.Equal(uint64(7), observed.DepositNonce)
+	s.Equal(common.HexToAddress("0x02"), *observed.Handler)
+	s.Equal([]byte{0x01}, []byte(observed.Data))
+	s.Equal("executed", observed.Status)
+	s.NotNil(observed.DataHash)
+	s.Empty(observed.Error)
+}
+
+func (s *MessageRecorderTestSuite) TestRecordsHandlingError() {
+	s.handler.err = errors.New("no handler for resource")
+	s.Nil(s.rec.VoteProposal(&message.Message{Source: 1, DepositNonce: 7}))
+
+	var observed ObservedMessage
+	s.Nil(json.Unmarshal(s.out.Bytes(), &observed))
+	s.Equal("no handler for resource", observed.Error)
+	s.Nil(observed.DataHash)
+}
+
+func (s *MessageRecorderTestSuite) TestClosesFile() {
+	path := filepath.Join(s.T().TempDir(), "observed.jsonl")
+	out, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	s.Nil(err)
+	rec := NewMessageRecorder(s.handler, &fakeStatusReader{}, out)
+	s.Nil(rec.VoteProposal(&message.Message{Source: 1, DepositNonce: 7}))
+
+	s.Nil(rec.Close())
+	s.Nil(rec.Close())
+	s.NotNil(rec.VoteProposal(&message.Message{Source: 1, DepositNonce: 8}))
+	// the file is closed by the recorder
+	s.NotNil(out.Close())
+
+	data, err := ioutil.ReadFile(path)
+	s.Nil(err)
+	s.Equal(1, bytes.Count(data, []byte("\n")))
+}
+
+// failingSyncFile fails to sync and records whether it was closed
+type failingSyncFile struct {
+	bytes.Buffer
+	closeErr error
+	closed   bool
+}
+
+func (f *failingSyncFile) Sync() error {
+	return errors.New("sync failed")
+}
+
+func (f *failingSyncFile) Close() error {
+	f.closed = true
+	return f.closeErr
+}
+
+func (s *MessageRecorderTestSuite) TestClosesFileIfSyncFails() {
+	out := &failingSyncFile{closeErr: errors.New("close failed")}
+	rec := NewMessageRecorder(s.handler, &fakeStatusReader{}, out)
+
+	s.EqualError(rec.Close(), "sync failed")
+	s.True(out.closed)
+}
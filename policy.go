@@ -0,0 +1,55 @@
+package celo
+
+import (
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var relayerBridgeMethods = []string{"voteProposal", "executeProposal"}
+
+// NewSigningPolicy creates the policy guarding the relayer key. Besides calls
+// configured in policy.allowedCalls, only voting on and executing proposals
+// on the bridge is allowed, without any value and within the configured gas limit.
+func NewSigningPolicy(config *CeloConfig) (*transaction.SigningPolicy, error) {
+	abis, err := transaction.BridgeABIs()
+	if err != nil {
+		return nil, err
+	}
+
+	policy := transaction.NewSigningPolicy()
+	policy.ABIs = abis
+	// transactions are sent with the default transactor gas limit unless a higher one is configured
+	policy.MaxGasLimit = config.GasLimit.Uint64()
+	if policy.MaxGasLimit < signAndSend.DefaultTransactionOptions.GasLimit {
+		policy.MaxGasLimit = signAndSend.DefaultTransactionOptions.GasLimit
+	}
+	policy.MaxValue = big.NewInt(0)
+	if config.Policy.MaxValue != "" {
+		policy.MaxValue, _ = new(big.Int).SetString(config.Policy.MaxValue, 10)
+	}
+	for _, feeCurrency := range config.Policy.FeeCurrencies {
+		policy.FeeCurrencies = append(policy.FeeCurrencies, common.HexToAddress(feeCurrency))
+	}
+
+	bridgeAddress := common.HexToAddress(config.Bridge)
+	for _, method := range relayerBridgeMethods {
+		selector, err := transaction.ParseSelector(method, abis...)
+		if err != nil {
+			return nil, err
+		}
+		policy.Allow(bridgeAddress, selector)
+	}
+	for contract, methods := range config.Policy.AllowedCalls {
+		for _, method := range methods {
+			selector, err := transaction.ParseSelector(method, abis...)
+			if err != nil {
+				return nil, err
+			}
+			policy.Allow(common.HexToAddress(contract), selector)
+		}
+	}
+	return policy, nil
+}
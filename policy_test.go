@@ -0,0 +1,53 @@
+package celo
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/config/chain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelayerSigningPolicyTestSuite struct {
+	suite.Suite
+	bridge common.Address
+	policy *transaction.SigningPolicy
+}
+
+func TestRunRelayerSigningPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayerSigningPolicyTestSuite))
+}
+
+func (s *RelayerSigningPolicyTestSuite) SetupTest() {
+	s.bridge = common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+	policy, err := NewSigningPolicy(&CeloConfig{
+		EVMConfig: &chain.EVMConfig{
+			Bridge:   s.bridge.Hex(),
+			GasLimit: big.NewInt(0),
+		},
+	})
+	s.Nil(err)
+	s.policy = policy
+}
+
+func (s *RelayerSigningPolicyTestSuite) call(signature string, value int64) *transaction.CeloTransaction {
+	selector, err := transaction.ParseSelector(signature)
+	s.Nil(err)
+	tx, err := transaction.NewCeloTransaction(0, &s.bridge, big.NewInt(value), 1000000, []*big.Int{big.NewInt(1)}, append(selector[:], make([]byte, 32)...))
+	s.Nil(err)
+	return tx.(*transaction.CeloTransaction)
+}
+
+func (s *RelayerSigningPolicyTestSuite) TestAllowsVotingAndExecuting() {
+	s.Nil(s.policy.Check(s.call("voteProposal(uint8,uint64,bytes32,bytes)", 0)))
+	s.Nil(s.policy.Check(s.call("executeProposal(uint8,uint64,bytes,bytes32,bool)", 0)))
+}
+
+func (s *RelayerSigningPolicyTestSuite) TestRejectsOtherCalls() {
+	var violation *transaction.PolicyViolationError
+	s.True(errors.As(s.policy.Check(s.call("cancelProposal(uint8,uint64,bytes32)", 0)), &violation))
+	s.True(errors.As(s.policy.Check(s.call("voteProposal(uint8,uint64,bytes32,bytes)", 1)), &violation))
+}
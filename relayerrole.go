@@ -0,0 +1,114 @@
+package celo
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultRelayerCheckInterval is how often the relayer role of the key is re-checked
+const DefaultRelayerCheckInterval = 10 * time.Minute
+
+// Modes of a chain whose key is not a relayer of the bridge
+const (
+	// NotRelayerRefuse makes SetupCeloChain fail
+	NotRelayerRefuse = "refuse"
+	// NotRelayerListen starts the chain without voting on proposals
+	NotRelayerListen = "listen"
+)
+
+// RelayerChecker reports whether an address holds the relayer role of a bridge
+type RelayerChecker interface {
+	IsRelayer(relayerAddress common.Address) (bool, error)
+}
+
+// RelayerRoleGuard passes messages to the voter only while the relayer key holds
+// the relayer role of the bridge. Votes of any other key revert, so without the
+// role the chain only listens and logs the messages it skips with their deposit
+// nonce. Skipped messages are not queued: once the role is granted they have to
+// be retried manually by restarting the relayer with --fresh and the startBlock
+// of the source chain at or before the deposit.
+type RelayerRoleGuard struct {
+	voter    evm.ProposalVoter
+	bridge   RelayerChecker
+	relayer  common.Address
+	domainID uint8
+
+	lock      sync.RWMutex
+	isRelayer bool
+	checked   bool
+}
+
+func NewRelayerRoleGuard(voter evm.ProposalVoter, bridge RelayerChecker, relayer common.Address, domainID uint8) *RelayerRoleGuard {
+	return &RelayerRoleGuard{
+		voter:    voter,
+		bridge:   bridge,
+		relayer:  relayer,
+		domainID: domainID,
+	}
+}
+
+// Check queries the relayer role of the key and warns when it differs from the
+// previous check. A failed query keeps the previous state.
+func (g *RelayerRoleGuard) Check() (bool, error) {
+	isRelayer, err := g.bridge.IsRelayer(g.relayer)
+	if err != nil {
+		return g.IsRelayer(), err
+	}
+
+	g.lock.Lock()
+	changed := !g.checked || g.isRelayer != isRelayer
+	g.isRelayer, g.checked = isRelayer, true
+	g.lock.Unlock()
+
+	if changed {
+		if isRelayer {
+			log.Info().Uint8("domainID", g.domainID).Msgf("%s is a relayer of the bridge, voting on proposals", g.relayer.Hex())
+		} else {
+			log.Error().Uint8("domainID", g.domainID).Msgf("%s IS NOT A RELAYER of the bridge, the chain is LISTEN-ONLY and won't vote on proposals until the role is granted", g.relayer.Hex())
+		}
+	}
+	return isRelayer, nil
+}
+
+// Watch re-checks the relayer role every interval until stop is closed, which
+// catches the role being revoked or granted while the relayer runs
+func (g *RelayerRoleGuard) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if _, err := g.Check(); err != nil {
+				log.Warn().Uint8("domainID", g.domainID).Msgf("Failed re-checking relayer role of %s: %s", g.relayer.Hex(), err)
+			}
+		}
+	}
+}
+
+func (g *RelayerRoleGuard) IsRelayer() bool {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.isRelayer
+}
+
+func (g *RelayerRoleGuard) VoteProposal(m *message.Message) error {
+	if !g.IsRelayer() {
+		// skipped messages are not retried, see RelayerRoleGuard
+		log.Error().
+			Uint8("source", m.Source).
+			Uint8("destination", m.Destination).
+			Uint64("nonce", m.DepositNonce).
+			Str("resourceID", hexutil.Encode(m.ResourceId[:])).
+			Msgf("Skipped vote on proposal, %s is not a relayer of the bridge. The vote is not retried, relay the deposit again once the role is granted", g.relayer.Hex())
+		return nil
+	}
+	return g.voter.VoteProposal(m)
+}
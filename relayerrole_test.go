@@ -0,0 +1,115 @@
+package celo
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeRelayerChecker struct {
+	lock      sync.Mutex
+	isRelayer bool
+	err       error
+}
+
+func (c *fakeRelayerChecker) IsRelayer(common.Address) (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.isRelayer, c.err
+}
+
+func (c *fakeRelayerChecker) setRelayer(isRelayer bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.isRelayer = isRelayer
+}
+
+type fakeVoter struct {
+	votes []*message.Message
+}
+
+func (v *fakeVoter) VoteProposal(m *message.Message) error {
+	v.votes = append(v.votes, m)
+	return nil
+}
+
+type RelayerRoleGuardTestSuite struct {
+	suite.Suite
+	checker *fakeRelayerChecker
+	voter   *fakeVoter
+	guard   *RelayerRoleGuard
+}
+
+func TestRunRelayerRoleGuardTestSuite(t *testing.T) {
+	suite.Run(t, new(RelayerRoleGuardTestSuite))
+}
+
+func (s *RelayerRoleGuardTestSuite) SetupTest() {
+	s.checker = &fakeRelayerChecker{}
+	s.voter = &fakeVoter{}
+	s.guard = NewRelayerRoleGuard(s.voter, s.checker, common.HexToAddress("0x01"), 1)
+}
+
+func (s *RelayerRoleGuardTestSuite) TestVotesWhileRelayer() {
+	s.checker.isRelayer = true
+	isRelayer, err := s.guard.Check()
+	s.Nil(err)
+	s.True(isRelayer)
+
+	s.Nil(s.guard.VoteProposal(&message.Message{DepositNonce: 1}))
+	s.Len(s.voter.votes, 1)
+}
+
+func (s *RelayerRoleGuardTestSuite) TestSkipsVotesWithoutRole() {
+	isRelayer, err := s.guard.Check()
+	s.Nil(err)
+	s.False(isRelayer)
+
+	s.Nil(s.guard.VoteProposal(&message.Message{DepositNonce: 1}))
+	s.Len(s.voter.votes, 0)
+}
+
+func (s *RelayerRoleGuardTestSuite) TestStopsVotingOnRevocation() {
+	s.checker.isRelayer = true
+	_, _ = s.guard.Check()
+	s.checker.isRelayer = false
+	_, _ = s.guard.Check()
+
+	s.Nil(s.guard.VoteProposal(&message.Message{DepositNonce: 1}))
+	s.Len(s.voter.votes, 0)
+}
+
+func (s *RelayerRoleGuardTestSuite) TestKeepsStateOnFailedCheck() {
+	s.checker.isRelayer = true
+	_, _ = s.guard.Check()
+	s.checker.err = errors.New("connection refused")
+
+	isRelayer, err := s.guard.Check()
+	s.NotNil(err)
+	s.True(isRelayer)
+	s.True(s.guard.IsRelayer())
+}
+
+func (s *RelayerRoleGuardTestSuite) TestWatchStopsWithChain() {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		s.guard.Watch(time.Millisecond, stop)
+		close(done)
+	}()
+
+	s.checker.setRelayer(true)
+	s.Eventually(s.guard.IsRelayer, time.Second, time.Millisecond)
+
+	close(stop)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		s.Fail("Watch did not return after stop was closed")
+	}
+}
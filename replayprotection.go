@@ -0,0 +1,26 @@
+package celo
+
+import (
+	"sync"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+)
+
+var (
+	replayProtectionLock sync.Mutex
+	// strictChainSetUp is set once a chain without allowUnprotectedTransactions is set up
+	strictChainSetUp bool
+)
+
+// setupReplayProtection applies the replay protection mode of a chain being set
+// up. The mode is global to the process, so strict mode wins: it is disabled
+// only if every chain set up so far allows unprotected transactions, and once a
+// chain requires it it stays enabled regardless of the order chains are set up in.
+func setupReplayProtection(config *CeloConfig) {
+	replayProtectionLock.Lock()
+	defer replayProtectionLock.Unlock()
+	if !config.AllowUnprotectedTransactions {
+		strictChainSetUp = true
+	}
+	transaction.SetStrictReplayProtection(strictChainSetUp)
+}
@@ -0,0 +1,36 @@
+package celo
+
+import (
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReplayProtectionTestSuite struct {
+	suite.Suite
+}
+
+func TestRunReplayProtectionTestSuite(t *testing.T) {
+	suite.Run(t, new(ReplayProtectionTestSuite))
+}
+
+func (s *ReplayProtectionTestSuite) TearDownTest() {
+	strictChainSetUp = false
+	transaction.SetStrictReplayProtection(false)
+}
+
+func (s *ReplayProtectionTestSuite) TestDisabledIfEveryChainOptsOut() {
+	setupReplayProtection(&CeloConfig{AllowUnprotectedTransactions: true})
+	setupReplayProtection(&CeloConfig{AllowUnprotectedTransactions: true})
+	s.False(transaction.StrictReplayProtection())
+}
+
+func (s *ReplayProtectionTestSuite) TestStrictWinsRegardlessOfOrder() {
+	setupReplayProtection(&CeloConfig{AllowUnprotectedTransactions: true})
+	setupReplayProtection(&CeloConfig{})
+	s.True(transaction.StrictReplayProtection())
+
+	setupReplayProtection(&CeloConfig{AllowUnprotectedTransactions: true})
+	s.True(transaction.StrictReplayProtection())
+}
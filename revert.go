@@ -0,0 +1,85 @@
+package celo
+
+import (
+	"sync"
+	"time"
+
+	celoClient "github.com/ChainSafe/chainbridge-celo-module/client"
+	"github.com/ChainSafe/chainbridge-celo-module/transaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+// ReceiptTimeout is how long the relayer waits for the receipt of a sent
+// transaction, which matches the receipt polling of the chainbridge-core transactor
+var ReceiptTimeout = 250 * time.Second
+
+// simulateVoteAttempts is how often the chainbridge-core voter simulates a vote
+// before it gives up on the proposal
+const simulateVoteAttempts = 6
+
+// RevertDecodingBridge logs the decoded revert reasons of the proposal votes the
+// voter simulates and sends, which the voter itself only reports as generic errors
+type RevertDecodingBridge struct {
+	*bridge.BridgeContract
+	abis []abi.ABI
+
+	lock sync.Mutex
+	// failedSimulations counts the consecutive failed simulations by proposal
+	failedSimulations map[common.Hash]int
+}
+
+func NewRevertDecodingBridge(bridgeContract *bridge.BridgeContract, abis []abi.ABI) *RevertDecodingBridge {
+	return &RevertDecodingBridge{
+		BridgeContract:    bridgeContract,
+		abis:              abis,
+		failedSimulations: make(map[common.Hash]int),
+	}
+}
+
+// SimulateVoteProposal simulates the vote on p. The voter retries failed
+// simulations right away, so the revert reason is only logged once the last
+// attempt failed.
+func (b *RevertDecodingBridge) SimulateVoteProposal(p *proposal.Proposal) error {
+	err := transaction.WrapRevert(b.BridgeContract.SimulateVoteProposal(p), b.abis...)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	id := p.GetID()
+	if err == nil {
+		delete(b.failedSimulations, id)
+		return nil
+	}
+	b.failedSimulations[id]++
+	if b.failedSimulations[id] >= simulateVoteAttempts {
+		delete(b.failedSimulations, id)
+		log.Warn().Uint8("source", p.Source).Uint64("nonce", p.DepositNonce).Msgf("Simulating vote failed: %v", err)
+	}
+	return err
+}
+
+func (b *RevertDecodingBridge) VoteProposal(p *proposal.Proposal, opts transactor.TransactOptions) (*common.Hash, error) {
+	h, err := b.BridgeContract.VoteProposal(p, opts)
+	if err != nil {
+		log.Error().Uint8("source", p.Source).Uint64("nonce", p.DepositNonce).Msgf("Voting failed: %v", err)
+	}
+	return h, err
+}
+
+// logTxStatus logs the status of a transaction sent by the relayer
+func logTxStatus(s *celoClient.TxStatus) {
+	if !s.Success {
+		log.Error().
+			Str("hash", s.Hash.Hex()).
+			Str("block", s.BlockNumber.String()).
+			Uint64("gasUsed", s.GasUsed).
+			Str("reason", s.RevertReason).
+			Msg("Transaction reverted")
+		return
+	}
+	log.Debug().Str("hash", s.Hash.Hex()).Uint64("gasUsed", s.GasUsed).Msg("Transaction included")
+}
@@ -0,0 +1,78 @@
+package celo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-celo-module/internal/calltest"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/voter/proposal"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type RevertDecodingBridgeTestSuite struct {
+	suite.Suite
+	caller        *calltest.ContractCaller
+	bridge        *RevertDecodingBridge
+	logs          *bytes.Buffer
+	logger        zerolog.Logger
+	bridgeAddress common.Address
+}
+
+func TestRunRevertDecodingBridgeTestSuite(t *testing.T) {
+	suite.Run(t, new(RevertDecodingBridgeTestSuite))
+}
+
+func (s *RevertDecodingBridgeTestSuite) SetupTest() {
+	s.bridgeAddress = common.HexToAddress("0xb")
+	s.caller = calltest.NewContractCaller()
+	s.bridge = NewRevertDecodingBridge(bridge.NewBridgeContract(s.caller, s.bridgeAddress, nil), nil)
+	s.logs = new(bytes.Buffer)
+	s.logger = log.Logger
+	log.Logger = zerolog.New(s.logs)
+}
+
+func (s *RevertDecodingBridgeTestSuite) TearDownTest() {
+	log.Logger = s.logger
+}
+
+func (s *RevertDecodingBridgeTestSuite) simulate(p *proposal.Proposal, times int) {
+	for i := 0; i < times; i++ {
+		s.NotNil(s.bridge.SimulateVoteProposal(p))
+	}
+}
+
+func (s *RevertDecodingBridgeTestSuite) failures() int {
+	return strings.Count(s.logs.String(), "Simulating vote failed")
+}
+
+func (s *RevertDecodingBridgeTestSuite) TestLogsOnlyAfterLastAttempt() {
+	p := proposal.NewProposal(1, 7, [32]byte{}, nil, common.Address{}, s.bridgeAddress)
+
+	s.simulate(p, simulateVoteAttempts-1)
+	s.Equal(0, s.failures())
+
+	s.simulate(p, 1)
+	s.Equal(1, s.failures())
+
+	s.simulate(p, simulateVoteAttempts)
+	s.Equal(2, s.failures())
+}
+
+func (s *RevertDecodingBridgeTestSuite) TestSuccessResetsAttempts() {
+	p := proposal.NewProposal(1, 7, [32]byte{}, nil, common.Address{}, s.bridgeAddress)
+
+	s.simulate(p, simulateVoteAttempts-1)
+	s.caller.Respond(s.bridgeAddress, consts.BridgeABI, "voteProposal")
+	s.Nil(s.bridge.SimulateVoteProposal(p))
+	s.caller = calltest.NewContractCaller()
+	s.bridge.BridgeContract = bridge.NewBridgeContract(s.caller, s.bridgeAddress, nil)
+
+	s.simulate(p, simulateVoteAttempts-1)
+	s.Equal(0, s.failures())
+}
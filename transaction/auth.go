@@ -32,7 +32,8 @@ func NewTransactor(keyin io.Reader, passphrase string) (*TransactOpts, error) {
 // an decrypted key from a keystore
 func NewKeyStoreTransactor(keystore *keystore.KeyStore, account accounts.Account) (*TransactOpts, error) {
 	return &TransactOpts{
-		From: account.Address,
+		From:            account.Address,
+		TypedDataSigner: NewKeyStoreTypedDataSignerFn(keystore, account),
 		Signer: func(signer CeloSigner, address common.Address, tx *CeloTransaction) (*CeloTransaction, error) {
 			if address != account.Address {
 				return nil, errors.New("not authorized to sign this account")
@@ -51,7 +52,8 @@ func NewKeyStoreTransactor(keystore *keystore.KeyStore, account accounts.Account
 func NewKeyedTransactor(key *ecdsa.PrivateKey) *TransactOpts {
 	keyAddr := crypto.PubkeyToAddress(key.PublicKey)
 	return &TransactOpts{
-		From: keyAddr,
+		From:            keyAddr,
+		TypedDataSigner: NewKeyedTypedDataSignerFn(key),
 		Signer: func(signer CeloSigner, address common.Address, tx *CeloTransaction) (*CeloTransaction, error) {
 			if address != keyAddr {
 				return nil, errors.New("not authorized to sign this account")
@@ -72,6 +74,8 @@ type TransactOpts struct {
 	Nonce  *big.Int       // Nonce to use for the transaction execution (nil = use pending state)
 	Signer SignerFn       // Method to use for signing the transaction (mandatory)
 
+	TypedDataSigner TypedDataSignerFn // Method to use for signing EIP-712 typed data (nil = not supported)
+
 	Value               *big.Int        // Funds to transfer along along the transaction (nil = 0 = no funds)
 	GasPrice            *big.Int        // Gas price to use for the transaction execution (nil = gas price oracle)
 	FeeCurrency         *common.Address // Fee currency to be used for transaction (nil = default currency = Celo Gold)
@@ -83,3 +87,6 @@ type TransactOpts struct {
 }
 
 type SignerFn func(CeloSigner, common.Address, *CeloTransaction) (*CeloTransaction, error)
+
+// SendFn signs tx of the account with signer and sends it to the network
+type SendFn func(context.Context, CeloSigner, common.Address, *CeloTransaction) (common.Hash, error)
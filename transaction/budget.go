@@ -0,0 +1,191 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// ErrBudgetExceeded is returned if signing a transaction would exceed the account budget
+	ErrBudgetExceeded = errors.New("transaction exceeds spending budget")
+)
+
+// FeeConverter converts amounts paid in a fee currency to CELO
+type FeeConverter interface {
+	ToCelo(feeCurrency common.Address, amount *big.Int) (*big.Int, error)
+}
+
+// AlertFn is called when an account spending crosses the budget alert threshold
+type AlertFn func(account common.Address, spent *big.Int, budget *big.Int)
+
+type spending struct {
+	at     time.Time
+	amount *big.Int
+}
+
+// Charge is a spending recorded by BudgetTracker.Charge, which can be refunded
+type Charge struct {
+	account  common.Address
+	spending *spending
+}
+
+// BudgetTracker limits the CELO an account may spend on transactions within a
+// rolling window. Spending is accounted for when a transaction is sent.
+type BudgetTracker struct {
+	budget         *big.Int
+	window         time.Duration
+	alertThreshold *big.Rat
+	converter      FeeConverter
+	onAlert        AlertFn
+
+	lock     sync.Mutex
+	spent    map[common.Address][]*spending
+	alerted  map[common.Address]bool
+	timeFunc func() time.Time
+}
+
+// NewBudgetTracker creates a tracker that allows spending budget CELO per window.
+// Once alertThreshold (e.g. 0.8) of the budget is spent a warning is logged and
+// onAlert is called. Converter is used to price fees paid in stable tokens and
+// can be nil if only CELO is used for fees.
+func NewBudgetTracker(budget *big.Int, window time.Duration, alertThreshold float64, converter FeeConverter, onAlert AlertFn) *BudgetTracker {
+	return &BudgetTracker{
+		budget:         budget,
+		window:         window,
+		alertThreshold: alertRatio(alertThreshold),
+		converter:      converter,
+		onAlert:        onAlert,
+		spent:          make(map[common.Address][]*spending),
+		alerted:        make(map[common.Address]bool),
+		timeFunc:       time.Now,
+	}
+}
+
+// CeloCost returns the transaction Cost in CELO
+func (b *BudgetTracker) CeloCost(tx *CeloTransaction) (*big.Int, error) {
+	feeCurrency := tx.FeeCurrency()
+	if feeCurrency == nil {
+		return tx.Cost(), nil
+	}
+	if b.converter == nil {
+		return nil, fmt.Errorf("no converter for fee currency %s", feeCurrency.Hex())
+	}
+	fee, err := b.converter.ToCelo(*feeCurrency, tx.Fee())
+	if err != nil {
+		return nil, err
+	}
+	return fee.Add(fee, tx.Value()), nil
+}
+
+// Spent returns the CELO spent by account within the current window
+func (b *BudgetTracker) Spent(account common.Address) *big.Int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.prune(account)
+}
+
+// prune drops spendings outside of the window and returns the remaining total.
+// Must be called with the lock held.
+func (b *BudgetTracker) prune(account common.Address) *big.Int {
+	cutoff := b.timeFunc().Add(-b.window)
+	total := new(big.Int)
+	kept := b.spent[account][:0]
+	for _, s := range b.spent[account] {
+		if s.at.After(cutoff) {
+			kept = append(kept, s)
+			total.Add(total, s.amount)
+		}
+	}
+	b.spent[account] = kept
+	if !b.isAboveThreshold(total) {
+		b.alerted[account] = false
+	}
+	return total
+}
+
+func (b *BudgetTracker) isAboveThreshold(spent *big.Int) bool {
+	threshold := new(big.Rat).Mul(new(big.Rat).SetInt(b.budget), b.alertThreshold)
+	return new(big.Rat).SetInt(spent).Cmp(threshold) >= 0
+}
+
+// alertRatio converts the threshold through its shortest decimal representation,
+// so that e.g. 0.8 is exactly 4/5 rather than the nearest float64
+func alertRatio(threshold float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(threshold, 'f', -1, 64))
+	return r
+}
+
+// Charge records cost for account or returns ErrBudgetExceeded if it doesn't fit in the budget
+func (b *BudgetTracker) Charge(account common.Address, cost *big.Int) (*Charge, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	spent := b.prune(account)
+	total := new(big.Int).Add(spent, cost)
+	if total.Cmp(b.budget) > 0 {
+		return nil, fmt.Errorf("%w: spent %s, cost %s, budget %s", ErrBudgetExceeded, spent, cost, b.budget)
+	}
+	charge := &Charge{account: account, spending: &spending{at: b.timeFunc(), amount: cost}}
+	b.spent[account] = append(b.spent[account], charge.spending)
+
+	if b.isAboveThreshold(total) && !b.alerted[account] {
+		b.alerted[account] = true
+		log.Warn().
+			Str("account", account.Hex()).
+			Str("spent", total.String()).
+			Str("budget", b.budget.String()).
+			Str("window", b.window.String()).
+			Msgf("Account spent %s of its %s budget", total, b.budget)
+		if b.onAlert != nil {
+			b.onAlert(account, total, b.budget)
+		}
+	}
+	return charge, nil
+}
+
+// Refund removes charge from the account spending
+func (b *BudgetTracker) Refund(charge *Charge) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	spent := b.spent[charge.account]
+	for i, s := range spent {
+		if s == charge.spending {
+			b.spent[charge.account] = append(spent[:i], spent[i+1:]...)
+			break
+		}
+	}
+	b.prune(charge.account)
+}
+
+// SendFn returns a SendFn that charges the transaction cost to the sending
+// account before passing it to sendFn. The cost is charged up front so that
+// concurrently sent transactions can't exceed the budget together, and is
+// refunded if signing or sending the transaction fails.
+func (b *BudgetTracker) SendFn(sendFn SendFn) SendFn {
+	return func(ctx context.Context, signer CeloSigner, address common.Address, tx *CeloTransaction) (common.Hash, error) {
+		cost, err := b.CeloCost(tx)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		charge, err := b.Charge(address, cost)
+		if err != nil {
+			log.Error().Str("account", address.Hex()).Err(err).Msg("Refused to sign transaction")
+			return common.Hash{}, err
+		}
+		h, err := sendFn(ctx, signer, address, tx)
+		if err != nil {
+			b.Refund(charge)
+			return common.Hash{}, err
+		}
+		return h, nil
+	}
+}
@@ -0,0 +1,124 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+)
+
+type BudgetTrackerTestSuite struct {
+	suite.Suite
+	tracker *BudgetTracker
+	account common.Address
+	now     time.Time
+	alerts  int
+}
+
+func TestRunBudgetTrackerTestSuite(t *testing.T) {
+	suite.Run(t, new(BudgetTrackerTestSuite))
+}
+
+func (s *BudgetTrackerTestSuite) SetupTest() {
+	s.account = common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+	s.now = time.Unix(1600000000, 0)
+	s.alerts = 0
+	s.tracker = NewBudgetTracker(big.NewInt(1000), time.Hour, 0.8, nil, func(common.Address, *big.Int, *big.Int) {
+		s.alerts++
+	})
+	s.tracker.timeFunc = func() time.Time { return s.now }
+}
+
+func (s *BudgetTrackerTestSuite) TestRefusesOverBudget() {
+	s.Nil(s.charge(s.account, big.NewInt(600)))
+	s.Nil(s.charge(s.account, big.NewInt(400)))
+
+	err := s.charge(s.account, big.NewInt(1))
+	s.True(errors.Is(err, ErrBudgetExceeded))
+	s.Equal(big.NewInt(1000), s.tracker.Spent(s.account))
+
+	s.Nil(s.charge(common.HexToAddress("0x1"), big.NewInt(1000)))
+}
+
+func (s *BudgetTrackerTestSuite) TestPrunesSpendingOutsideWindow() {
+	s.Nil(s.charge(s.account, big.NewInt(600)))
+	s.now = s.now.Add(30 * time.Minute)
+	s.Nil(s.charge(s.account, big.NewInt(300)))
+
+	s.now = s.now.Add(31 * time.Minute)
+	s.Equal(big.NewInt(300), s.tracker.Spent(s.account))
+	s.Nil(s.charge(s.account, big.NewInt(700)))
+
+	s.now = s.now.Add(2 * time.Hour)
+	s.Equal(big.NewInt(0), s.tracker.Spent(s.account))
+}
+
+func (s *BudgetTrackerTestSuite) TestAlertsOnceAboveThresholdAndResets() {
+	s.Nil(s.charge(s.account, big.NewInt(700)))
+	s.Equal(0, s.alerts)
+	s.Nil(s.charge(s.account, big.NewInt(100)))
+	s.Equal(1, s.alerts)
+	s.Nil(s.charge(s.account, big.NewInt(100)))
+	s.Equal(1, s.alerts)
+
+	s.now = s.now.Add(2 * time.Hour)
+	s.Nil(s.charge(s.account, big.NewInt(900)))
+	s.Equal(2, s.alerts)
+}
+
+func (s *BudgetTrackerTestSuite) charge(account common.Address, cost *big.Int) error {
+	_, err := s.tracker.Charge(account, cost)
+	return err
+}
+
+func (s *BudgetTrackerTestSuite) TestRefundsOnlyTheGivenCharge() {
+	first, err := s.tracker.Charge(s.account, big.NewInt(300))
+	s.Nil(err)
+	s.now = s.now.Add(50 * time.Minute)
+	_, err = s.tracker.Charge(s.account, big.NewInt(300))
+	s.Nil(err)
+
+	s.tracker.Refund(first)
+	s.Equal(big.NewInt(300), s.tracker.Spent(s.account))
+	// the remaining charge is the later one, which is still in the window
+	s.now = s.now.Add(30 * time.Minute)
+	s.Equal(big.NewInt(300), s.tracker.Spent(s.account))
+
+	s.tracker.Refund(first)
+	s.Equal(big.NewInt(300), s.tracker.Spent(s.account))
+}
+
+func (s *BudgetTrackerTestSuite) TestRefundsWhenSigningOrSendingFails() {
+	to := common.HexToAddress("0x1")
+	tx := newTransaction(0, &to, big.NewInt(0), 100, []*big.Int{big.NewInt(5)}, nil, nil, nil, nil)
+	signer := NewEIP155Signer(big.NewInt(44787))
+	key, _ := crypto.GenerateKey()
+	sendErr := errors.New("connection refused")
+	sendFn := s.tracker.SendFn(func(ctx context.Context, signer CeloSigner, _ common.Address, tx *CeloTransaction) (common.Hash, error) {
+		if _, err := SignTx(tx, signer, key); err != nil {
+			return common.Hash{}, err
+		}
+		return common.Hash{}, sendErr
+	})
+	for i := 0; i < 3; i++ {
+		_, err := sendFn(context.Background(), signer, s.account, tx)
+		s.Equal(sendErr, err)
+	}
+	s.Equal(big.NewInt(0), s.tracker.Spent(s.account))
+
+	sendFn = s.tracker.SendFn(func(ctx context.Context, signer CeloSigner, _ common.Address, tx *CeloTransaction) (common.Hash, error) {
+		signed, err := SignTx(tx, signer, key)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		return signed.Hash(), nil
+	})
+	_, err := sendFn(context.Background(), signer, s.account, tx)
+	s.Nil(err)
+	s.Equal(big.NewInt(500), s.tracker.Spent(s.account))
+}
@@ -0,0 +1,96 @@
+package transaction
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	// ErrUnknownCall is returned if calldata matches no method of the provided ABIs
+	ErrUnknownCall = errors.New("calldata does not match any known method")
+)
+
+// Call is a contract call decoded with an ABI
+type Call struct {
+	Method    string    `json:"method"`
+	Signature string    `json:"signature"`
+	Args      []CallArg `json:"args"`
+}
+
+// CallArg is a decoded argument of a contract call
+type CallArg struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// DecodeCall decodes data with the first of abis that has a method matching its selector
+func DecodeCall(data []byte, abis ...abi.ABI) (*Call, error) {
+	if len(data) < 4 {
+		return nil, ErrUnknownCall
+	}
+	for _, a := range abis {
+		method, err := a.MethodById(data[:4])
+		if err != nil {
+			continue
+		}
+		values, err := method.Inputs.UnpackValues(data[4:])
+		if err != nil {
+			continue
+		}
+		call := &Call{Method: method.Name, Signature: method.Sig}
+		for i, input := range method.Inputs {
+			call.Args = append(call.Args, CallArg{Name: input.Name, Type: input.Type.String(), Value: values[i]})
+		}
+		return call, nil
+	}
+	return nil, ErrUnknownCall
+}
+
+func (c *Call) String() string {
+	values := make([]interface{}, len(c.Args))
+	for i, arg := range c.Args {
+		values[i] = arg.Value
+	}
+	return fmt.Sprintf("%s%v", c.Method, values)
+}
+
+// String returns the argument value with byte arrays and slices encoded as hex
+func (a CallArg) String() string {
+	if s, ok := a.Value.(fmt.Stringer); ok {
+		return s.String()
+	}
+	if b, ok := bytesValue(a.Value); ok {
+		return hexutil.Encode(b)
+	}
+	return fmt.Sprint(a.Value)
+}
+
+// MarshalJSON encodes byte arrays and slices of the argument value as hex
+func (a CallArg) MarshalJSON() ([]byte, error) {
+	type callArg CallArg
+	enc := callArg(a)
+	if _, ok := a.Value.(encoding.TextMarshaler); ok {
+		return json.Marshal(enc)
+	}
+	if b, ok := bytesValue(a.Value); ok {
+		enc.Value = hexutil.Bytes(b)
+	}
+	return json.Marshal(enc)
+}
+
+func bytesValue(value interface{}) ([]byte, bool) {
+	v := reflect.ValueOf(value)
+	if (v.Kind() != reflect.Slice && v.Kind() != reflect.Array) || v.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, false
+	}
+	b := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(b), v)
+	return b, true
+}
@@ -0,0 +1,67 @@
+package transaction
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type DecodeCallTestSuite struct {
+	suite.Suite
+	bridgeABI abi.ABI
+}
+
+func TestRunDecodeCallTestSuite(t *testing.T) {
+	suite.Run(t, new(DecodeCallTestSuite))
+}
+
+func (s *DecodeCallTestSuite) SetupSuite() {
+	s.bridgeABI, _ = abi.JSON(strings.NewReader(consts.BridgeABI))
+}
+
+func (s *DecodeCallTestSuite) TestDecodesCall() {
+	resourceID := common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000100")
+	data, err := s.bridgeABI.Pack("voteProposal", uint8(1), uint64(7), resourceID, []byte{0x01, 0x02})
+	s.Nil(err)
+
+	call, err := DecodeCall(data, s.bridgeABI)
+	s.Nil(err)
+	s.Equal("voteProposal", call.Method)
+	s.Equal("voteProposal(uint8,uint64,bytes32,bytes)", call.Signature)
+	s.Equal("7", call.Args[1].String())
+	s.Equal(resourceID.Hex(), call.Args[2].String())
+	s.Equal("0x0102", call.Args[3].String())
+
+	out, err := json.Marshal(call.Args[2])
+	s.Nil(err)
+	s.JSONEq(`{"name":"resourceID","type":"bytes32","value":"`+resourceID.Hex()+`"}`, string(out))
+}
+
+func (s *DecodeCallTestSuite) TestDecodesAddressArguments() {
+	relayer := common.HexToAddress("0xff93B45308FD417dF303D6515aB04D9e89a750Ca")
+	data, err := s.bridgeABI.Pack("adminChangeFee", big.NewInt(10))
+	s.Nil(err)
+	call, err := DecodeCall(data, s.bridgeABI)
+	s.Nil(err)
+	s.Equal("10", call.Args[0].String())
+
+	data, err = s.bridgeABI.Pack("adminAddRelayer", relayer)
+	s.Nil(err)
+	call, err = DecodeCall(data, s.bridgeABI)
+	s.Nil(err)
+	s.Equal(relayer.Hex(), call.Args[0].String())
+}
+
+func (s *DecodeCallTestSuite) TestUnknownCall() {
+	_, err := DecodeCall([]byte{0xde, 0xad, 0xbe, 0xef}, s.bridgeABI)
+	s.Equal(ErrUnknownCall, err)
+
+	_, err = DecodeCall([]byte{0xde}, s.bridgeABI)
+	s.Equal(ErrUnknownCall, err)
+}
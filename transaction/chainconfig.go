@@ -0,0 +1,212 @@
+package transaction
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CeloChainConfig holds the chain id and the Celo hardfork blocks of a network.
+// A nil fork block means the fork is not scheduled.
+type CeloChainConfig struct {
+	ChainID *big.Int
+
+	ChurritoBlock      *big.Int // Churrito switch block (nil = no fork, 0 = already activated)
+	DonutBlock         *big.Int // Donut switch block, enables eth compatible transactions
+	EspressoBlock      *big.Int // Espresso switch block, enables EIP-2930 and EIP-1559 transactions
+	GingerbreadBlock   *big.Int // Gingerbread switch block, enables CIP-42 transactions
+	GingerbreadP2Block *big.Int // Gingerbread P2 switch block, enables CIP-64 transactions
+}
+
+var (
+	// MainnetChainConfig is the chain config of the Celo mainnet
+	MainnetChainConfig = &CeloChainConfig{
+		ChainID:            big.NewInt(42220),
+		ChurritoBlock:      big.NewInt(6774000),
+		DonutBlock:         big.NewInt(6774000),
+		EspressoBlock:      big.NewInt(11838440),
+		GingerbreadBlock:   big.NewInt(21616000),
+		GingerbreadP2Block: big.NewInt(21616000),
+	}
+
+	// AlfajoresChainConfig is the chain config of the Alfajores testnet
+	AlfajoresChainConfig = &CeloChainConfig{
+		ChainID:            big.NewInt(44787),
+		ChurritoBlock:      big.NewInt(4960000),
+		DonutBlock:         big.NewInt(4960000),
+		EspressoBlock:      big.NewInt(9472000),
+		GingerbreadBlock:   big.NewInt(19814000),
+		GingerbreadP2Block: big.NewInt(19814000),
+	}
+
+	// BaklavaChainConfig is the chain config of the Baklava testnet
+	BaklavaChainConfig = &CeloChainConfig{
+		ChainID:            big.NewInt(62320),
+		ChurritoBlock:      big.NewInt(2719099),
+		DonutBlock:         big.NewInt(5002000),
+		EspressoBlock:      big.NewInt(9195000),
+		GingerbreadBlock:   big.NewInt(18785000),
+		GingerbreadP2Block: big.NewInt(19431000),
+	}
+)
+
+// CeloChainConfigByID returns the chain config of the known network with chainID.
+// Unknown networks, e.g. local devchains, are assumed to have every fork
+// activated at genesis.
+func CeloChainConfigByID(chainID *big.Int) *CeloChainConfig {
+	for _, c := range []*CeloChainConfig{MainnetChainConfig, AlfajoresChainConfig, BaklavaChainConfig} {
+		if c.ChainID.Cmp(chainID) == 0 {
+			return c
+		}
+	}
+	return &CeloChainConfig{
+		ChainID:            chainID,
+		ChurritoBlock:      big.NewInt(0),
+		DonutBlock:         big.NewInt(0),
+		EspressoBlock:      big.NewInt(0),
+		GingerbreadBlock:   big.NewInt(0),
+		GingerbreadP2Block: big.NewInt(0),
+	}
+}
+
+func (c *CeloChainConfig) IsChurrito(num *big.Int) bool {
+	return isForked(c.ChurritoBlock, num)
+}
+
+func (c *CeloChainConfig) IsDonut(num *big.Int) bool {
+	return isForked(c.DonutBlock, num)
+}
+
+func (c *CeloChainConfig) IsEspresso(num *big.Int) bool {
+	return isForked(c.EspressoBlock, num)
+}
+
+func (c *CeloChainConfig) IsGingerbread(num *big.Int) bool {
+	return isForked(c.GingerbreadBlock, num)
+}
+
+func (c *CeloChainConfig) IsGingerbreadP2(num *big.Int) bool {
+	return isForked(c.GingerbreadP2Block, num)
+}
+
+// isForked returns whether a fork scheduled at block s is active at the given head block.
+func isForked(s, head *big.Int) bool {
+	if s == nil || head == nil {
+		return false
+	}
+	return s.Cmp(head) <= 0
+}
+
+// CeloFork identifies the latest Celo hardfork active at a block
+type CeloFork int
+
+const (
+	ForkGenesis CeloFork = iota
+	ForkChurrito
+	ForkDonut
+	ForkEspresso
+	ForkGingerbread
+	ForkGingerbreadP2
+)
+
+func (f CeloFork) String() string {
+	switch f {
+	case ForkChurrito:
+		return "churrito"
+	case ForkDonut:
+		return "donut"
+	case ForkEspresso:
+		return "espresso"
+	case ForkGingerbread:
+		return "gingerbread"
+	case ForkGingerbreadP2:
+		return "gingerbreadP2"
+	default:
+		return "genesis"
+	}
+}
+
+// Fork returns the latest fork active at block num
+func (c *CeloChainConfig) Fork(num *big.Int) CeloFork {
+	switch {
+	case c.IsGingerbreadP2(num):
+		return ForkGingerbreadP2
+	case c.IsGingerbread(num):
+		return ForkGingerbread
+	case c.IsEspresso(num):
+		return ForkEspresso
+	case c.IsDonut(num):
+		return ForkDonut
+	case c.IsChurrito(num):
+		return ForkChurrito
+	default:
+		return ForkGenesis
+	}
+}
+
+// CeloForkSigner is an EIP155Signer aware of the transaction types the Celo
+// hardforks enable. It refuses transactions that are not valid at its fork.
+type CeloForkSigner struct {
+	EIP155Signer
+	fork CeloFork
+}
+
+// MakeCeloSigner returns the latest signer valid at blockNumber on the network described by config.
+func MakeCeloSigner(config *CeloChainConfig, blockNumber *big.Int) CeloForkSigner {
+	return CeloForkSigner{
+		EIP155Signer: NewEIP155Signer(config.ChainID),
+		fork:         config.Fork(blockNumber),
+	}
+}
+
+// Fork returns the hardfork the signer was created for
+func (s CeloForkSigner) Fork() CeloFork {
+	return s.fork
+}
+
+// AllowsEthCompatible returns true if eth compatible transactions are valid (Donut)
+func (s CeloForkSigner) AllowsEthCompatible() bool {
+	return s.fork >= ForkDonut
+}
+
+// AllowsTypedTransactions returns true if EIP-2930 and EIP-1559 transactions are valid (Espresso)
+func (s CeloForkSigner) AllowsTypedTransactions() bool {
+	return s.fork >= ForkEspresso
+}
+
+// AllowsCIP42 returns true if CIP-42 fee currency transactions are valid (Gingerbread)
+func (s CeloForkSigner) AllowsCIP42() bool {
+	return s.fork >= ForkGingerbread
+}
+
+// AllowsCIP64 returns true if CIP-64 fee currency transactions are valid (Gingerbread P2)
+func (s CeloForkSigner) AllowsCIP64() bool {
+	return s.fork >= ForkGingerbreadP2
+}
+
+func (s CeloForkSigner) Equal(s2 CeloSigner) bool {
+	fs, ok := s2.(CeloForkSigner)
+	return ok && fs.fork == s.fork && s.EIP155Signer.Equal(fs.EIP155Signer)
+}
+
+func (s CeloForkSigner) Sender(tx *CeloTransaction) (common.Address, error) {
+	if err := s.checkTx(tx); err != nil {
+		return common.Address{}, err
+	}
+	return s.EIP155Signer.Sender(tx)
+}
+
+func (s CeloForkSigner) SignatureValues(tx *CeloTransaction, sig []byte) (R, S, V *big.Int, err error) {
+	if err := s.checkTx(tx); err != nil {
+		return nil, nil, nil, err
+	}
+	return s.EIP155Signer.SignatureValues(tx, sig)
+}
+
+func (s CeloForkSigner) checkTx(tx *CeloTransaction) error {
+	if tx.EthCompatible() && !s.AllowsEthCompatible() {
+		return fmt.Errorf("eth compatible transactions are not valid before the donut fork (current fork %s)", s.fork)
+	}
+	return nil
+}
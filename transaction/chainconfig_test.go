@@ -0,0 +1,54 @@
+package transaction
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+)
+
+type CeloChainConfigTestSuite struct {
+	suite.Suite
+}
+
+func TestRunCeloChainConfigTestSuite(t *testing.T) {
+	suite.Run(t, new(CeloChainConfigTestSuite))
+}
+
+func (s *CeloChainConfigTestSuite) TestMakeCeloSignerForks() {
+	c := MainnetChainConfig
+	s.Equal(ForkGenesis, MakeCeloSigner(c, big.NewInt(1)).Fork())
+	s.Equal(ForkDonut, MakeCeloSigner(c, c.DonutBlock).Fork())
+	s.Equal(ForkEspresso, MakeCeloSigner(c, c.EspressoBlock).Fork())
+	s.Equal(ForkGingerbreadP2, MakeCeloSigner(c, c.GingerbreadP2Block).Fork())
+
+	c = BaklavaChainConfig
+	signer := MakeCeloSigner(c, c.GingerbreadBlock)
+	s.Equal(ForkGingerbread, signer.Fork())
+	s.True(signer.AllowsCIP42())
+	s.False(signer.AllowsCIP64())
+}
+
+func (s *CeloChainConfigTestSuite) TestUnknownChainHasAllForks() {
+	c := CeloChainConfigByID(big.NewInt(1337))
+	s.Equal(big.NewInt(1337), c.ChainID)
+	s.Equal(ForkGingerbreadP2, c.Fork(big.NewInt(0)))
+	s.Equal(AlfajoresChainConfig, CeloChainConfigByID(big.NewInt(44787)))
+}
+
+func (s *CeloChainConfigTestSuite) TestEthCompatibleRequiresDonut() {
+	key, _ := crypto.GenerateKey()
+	tx := newTransaction(0, nil, big.NewInt(0), 21000, []*big.Int{big.NewInt(1)}, nil, nil, nil, nil)
+	tx.data.EthCompatible = true
+
+	_, err := SignTx(tx, MakeCeloSigner(MainnetChainConfig, big.NewInt(1)), key)
+	s.NotNil(err)
+
+	signer := MakeCeloSigner(MainnetChainConfig, MainnetChainConfig.DonutBlock)
+	signed, err := SignTx(tx, signer, key)
+	s.Nil(err)
+	from, err := Sender(signer, signed)
+	s.Nil(err)
+	s.Equal(crypto.PubkeyToAddress(key.PublicKey), from)
+}
@@ -0,0 +1,92 @@
+package transaction
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// UnsignedTransaction is a transaction built on a networked machine that is
+// signed offline. Besides the transaction it carries the chain id and the head
+// block the transaction was built at, which select the signer without a node.
+type UnsignedTransaction struct {
+	ChainID     *hexutil.Big     `json:"chainId"`
+	BlockNumber *hexutil.Big     `json:"blockNumber"`
+	From        common.Address   `json:"from"`
+	Tx          *CeloTransaction `json:"tx"`
+}
+
+// NewFeeCurrencyTransaction creates a transaction that pays for gas in feeCurrency.
+// A nil feeCurrency means gas is paid in CELO.
+func NewFeeCurrencyTransaction(nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrice *big.Int, feeCurrency *common.Address, data []byte) *CeloTransaction {
+	return newTransaction(nonce, to, amount, gasLimit, []*big.Int{gasPrice}, feeCurrency, nil, nil, data)
+}
+
+// NewUnsignedTransaction wraps tx of from that is built at blockNumber of the chain with chainID
+func NewUnsignedTransaction(chainID, blockNumber *big.Int, from common.Address, tx *CeloTransaction) *UnsignedTransaction {
+	return &UnsignedTransaction{
+		ChainID:     (*hexutil.Big)(chainID),
+		BlockNumber: (*hexutil.Big)(blockNumber),
+		From:        from,
+		Tx:          tx,
+	}
+}
+
+// Validate checks that all fields required for signing are present
+func (u *UnsignedTransaction) Validate() error {
+	if u.ChainID == nil {
+		return errors.New("missing required field 'chainId'")
+	}
+	if u.BlockNumber == nil {
+		return errors.New("missing required field 'blockNumber'")
+	}
+	if u.Tx == nil {
+		return errors.New("missing required field 'tx'")
+	}
+	return nil
+}
+
+// MarshalJSON encodes the transaction without its hash, which changes once the
+// transaction is signed, and adds the hash the sender signs as signingHash.
+func (u *UnsignedTransaction) MarshalJSON() ([]byte, error) {
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+	tx, err := json.Marshal(u.Tx)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(tx, &fields); err != nil {
+		return nil, err
+	}
+	delete(fields, "hash")
+	return json.Marshal(struct {
+		ChainID     *hexutil.Big               `json:"chainId"`
+		BlockNumber *hexutil.Big               `json:"blockNumber"`
+		From        common.Address             `json:"from"`
+		Tx          map[string]json.RawMessage `json:"tx"`
+		SigningHash common.Hash                `json:"signingHash"`
+	}{u.ChainID, u.BlockNumber, u.From, fields, u.Signer().Hash(u.Tx)})
+}
+
+// Signer returns the signer valid at the block the transaction was built at
+func (u *UnsignedTransaction) Signer() CeloForkSigner {
+	return MakeCeloSigner(CeloChainConfigByID(u.ChainID.ToInt()), u.BlockNumber.ToInt())
+}
+
+// Sign signs the transaction with opts. The transaction can only be signed by
+// the account it was built for.
+func (u *UnsignedTransaction) Sign(opts *TransactOpts) (*CeloTransaction, error) {
+	if err := u.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.From != u.From {
+		return nil, fmt.Errorf("transaction is built for %s, not %s", u.From.Hex(), opts.From.Hex())
+	}
+	return opts.Signer(u.Signer(), opts.From, u.Tx)
+}
@@ -0,0 +1,83 @@
+package transaction
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/suite"
+)
+
+type OfflineSigningTestSuite struct {
+	suite.Suite
+}
+
+func TestRunOfflineSigningTestSuite(t *testing.T) {
+	suite.Run(t, new(OfflineSigningTestSuite))
+}
+
+func (s *OfflineSigningTestSuite) newUnsignedTx(from common.Address) *UnsignedTransaction {
+	to := common.HexToAddress("0x1")
+	feeCurrency := common.HexToAddress("0x874069Fa1Eb16D44d622F2e0Ca25eeA172369bC1")
+	tx := NewFeeCurrencyTransaction(3, &to, big.NewInt(0), 100000, big.NewInt(5), &feeCurrency, []byte{0xde, 0xad})
+	return NewUnsignedTransaction(big.NewInt(44787), big.NewInt(20000000), from, tx)
+}
+
+func (s *OfflineSigningTestSuite) TestSignsTransactionReadFromJSON() {
+	key, _ := crypto.GenerateKey()
+	opts := NewKeyedTransactor(key)
+
+	out, err := json.Marshal(s.newUnsignedTx(opts.From))
+	s.Nil(err)
+	var unsigned UnsignedTransaction
+	s.Nil(json.Unmarshal(out, &unsigned))
+
+	signed, err := unsigned.Sign(opts)
+	s.Nil(err)
+	s.Equal(uint64(3), signed.Nonce())
+	s.Equal("0x874069Fa1Eb16D44d622F2e0Ca25eeA172369bC1", signed.FeeCurrency().Hex())
+
+	raw, err := rlp.EncodeToBytes(signed)
+	s.Nil(err)
+	decoded := new(CeloTransaction)
+	s.Nil(rlp.DecodeBytes(raw, decoded))
+	from, err := Sender(unsigned.Signer(), decoded)
+	s.Nil(err)
+	s.Equal(opts.From, from)
+	s.Equal(signed.Hash(), decoded.Hash())
+}
+
+func (s *OfflineSigningTestSuite) TestRefusesToSignForOtherAccount() {
+	key, _ := crypto.GenerateKey()
+
+	_, err := s.newUnsignedTx(common.HexToAddress("0x2")).Sign(NewKeyedTransactor(key))
+	s.NotNil(err)
+}
+
+func (s *OfflineSigningTestSuite) TestRefusesIncompleteTransaction() {
+	key, _ := crypto.GenerateKey()
+	opts := NewKeyedTransactor(key)
+
+	var unsigned UnsignedTransaction
+	s.Nil(json.Unmarshal([]byte(`{"chainId":"0xaef3","from":"`+opts.From.Hex()+`"}`), &unsigned))
+	_, err := unsigned.Sign(opts)
+	s.NotNil(err)
+}
+
+func (s *OfflineSigningTestSuite) TestEncodesSigningHashInsteadOfHash() {
+	unsigned := s.newUnsignedTx(common.HexToAddress("0x2"))
+
+	out, err := json.Marshal(unsigned)
+	s.Nil(err)
+	var fields struct {
+		SigningHash common.Hash            `json:"signingHash"`
+		Tx          map[string]interface{} `json:"tx"`
+	}
+	s.Nil(json.Unmarshal(out, &fields))
+	s.Equal(unsigned.Signer().Hash(unsigned.Tx), fields.SigningHash)
+	s.NotContains(fields.Tx, "hash")
+	s.Contains(fields.Tx, "feeCurrency")
+}
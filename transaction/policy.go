@@ -0,0 +1,150 @@
+package transaction
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog/log"
+)
+
+// PolicyViolationError is returned if a transaction is rejected by a SigningPolicy
+type PolicyViolationError struct {
+	Reason string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("transaction rejected by signing policy: %s", e.Reason)
+}
+
+// SigningPolicy restricts which transactions are approved for signing
+type SigningPolicy struct {
+	AllowedCalls  map[common.Address][][4]byte // Contracts and function selectors that may be called
+	MaxValue      *big.Int                     // Maximum value transferred along with the transaction (nil = no limit)
+	MaxGasLimit   uint64                       // Maximum gas limit of the transaction (0 = no limit)
+	FeeCurrencies []common.Address             // Fee currencies besides CELO that fees may be paid in
+	ABIs          []abi.ABI                    // ABIs used to decode rejected calls for logs
+}
+
+// NewSigningPolicy creates an empty policy that rejects every contract call
+func NewSigningPolicy() *SigningPolicy {
+	return &SigningPolicy{
+		AllowedCalls: make(map[common.Address][][4]byte),
+	}
+}
+
+// Allow adds the function selectors to the calls allowed on contract
+func (p *SigningPolicy) Allow(contract common.Address, selectors ...[4]byte) {
+	p.AllowedCalls[contract] = append(p.AllowedCalls[contract], selectors...)
+}
+
+// Check returns a PolicyViolationError if tx is not allowed by the policy
+func (p *SigningPolicy) Check(tx *CeloTransaction) error {
+	to := tx.To()
+	if to == nil {
+		return &PolicyViolationError{Reason: "contract creation is not allowed"}
+	}
+	selectors, ok := p.AllowedCalls[*to]
+	if !ok {
+		return &PolicyViolationError{Reason: fmt.Sprintf("contract %s is not allowed", to.Hex())}
+	}
+	data := tx.Data()
+	if len(data) < 4 {
+		return &PolicyViolationError{Reason: "missing function selector"}
+	}
+	if !containsSelector(selectors, data[:4]) {
+		return &PolicyViolationError{Reason: fmt.Sprintf("function %s is not allowed on %s", hexutil.Encode(data[:4]), to.Hex())}
+	}
+	if p.MaxValue != nil && tx.Value().Cmp(p.MaxValue) > 0 {
+		return &PolicyViolationError{Reason: fmt.Sprintf("value %s exceeds maximum %s", tx.Value(), p.MaxValue)}
+	}
+	if p.MaxGasLimit != 0 && tx.Gas() > p.MaxGasLimit {
+		return &PolicyViolationError{Reason: fmt.Sprintf("gas limit %d exceeds maximum %d", tx.Gas(), p.MaxGasLimit)}
+	}
+	if feeCurrency := tx.FeeCurrency(); feeCurrency != nil && !containsAddress(p.FeeCurrencies, *feeCurrency) {
+		return &PolicyViolationError{Reason: fmt.Sprintf("fee currency %s is not allowed", feeCurrency.Hex())}
+	}
+	return nil
+}
+
+// DecodeCall returns a human readable representation of the transaction call
+// based on the policy ABIs.
+func (p *SigningPolicy) DecodeCall(tx *CeloTransaction) string {
+	call, err := DecodeCall(tx.Data(), p.ABIs...)
+	if err != nil {
+		return hexutil.Encode(tx.Data())
+	}
+	return call.String()
+}
+
+// NewPolicySignerFn returns a SignerFn that only passes transactions allowed by
+// policy to signerFn. Rejected transactions are logged with the decoded call.
+func NewPolicySignerFn(policy *SigningPolicy, signerFn SignerFn) SignerFn {
+	return func(signer CeloSigner, address common.Address, tx *CeloTransaction) (*CeloTransaction, error) {
+		if err := policy.Check(tx); err != nil {
+			to := "<nil>"
+			if tx.To() != nil {
+				to = tx.To().Hex()
+			}
+			log.Error().
+				Str("from", address.Hex()).
+				Str("to", to).
+				Str("value", tx.Value().String()).
+				Uint64("gas", tx.Gas()).
+				Str("call", policy.DecodeCall(tx)).
+				Err(err).
+				Msg("Refused to sign transaction")
+			return nil, err
+		}
+		return signerFn(signer, address, tx)
+	}
+}
+
+// ParseSelector parses a function selector given either as a 4 byte hex string,
+// a function signature such as "voteProposal(uint8,uint64,bytes32,bytes)" or
+// a method name from one of the provided ABIs.
+func ParseSelector(s string, abis ...abi.ABI) ([4]byte, error) {
+	var selector [4]byte
+	switch {
+	case strings.HasPrefix(s, "0x"):
+		b, err := hexutil.Decode(s)
+		if err != nil || len(b) != 4 {
+			return selector, fmt.Errorf("invalid function selector %s", s)
+		}
+		copy(selector[:], b)
+		return selector, nil
+	case strings.Contains(s, "("):
+		copy(selector[:], crypto.Keccak256([]byte(strings.ReplaceAll(s, " ", "")))[:4])
+		return selector, nil
+	default:
+		for _, a := range abis {
+			if method, ok := a.Methods[s]; ok {
+				copy(selector[:], method.ID)
+				return selector, nil
+			}
+		}
+		return selector, fmt.Errorf("unknown function %s", s)
+	}
+}
+
+func containsSelector(selectors [][4]byte, selector []byte) bool {
+	for _, s := range selectors {
+		if string(s[:]) == string(selector) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
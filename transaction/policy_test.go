@@ -0,0 +1,118 @@
+package transaction
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+)
+
+type SigningPolicyTestSuite struct {
+	suite.Suite
+	policy      *SigningPolicy
+	contract    common.Address
+	feeCurrency common.Address
+	selector    [4]byte
+}
+
+func TestRunSigningPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(SigningPolicyTestSuite))
+}
+
+func (s *SigningPolicyTestSuite) SetupTest() {
+	s.contract = common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+	s.feeCurrency = common.HexToAddress("0x874069Fa1Eb16D44d622F2e0Ca25eeA172369bC1")
+	s.selector, _ = ParseSelector("voteProposal(uint8,uint64,bytes32,bytes)")
+	s.policy = NewSigningPolicy()
+	s.policy.Allow(s.contract, s.selector)
+	s.policy.MaxValue = big.NewInt(100)
+	s.policy.MaxGasLimit = 2000000
+	s.policy.FeeCurrencies = []common.Address{s.feeCurrency}
+}
+
+func (s *SigningPolicyTestSuite) newTx(to *common.Address, value int64, gas uint64, feeCurrency *common.Address, data []byte) *CeloTransaction {
+	return newTransaction(0, to, big.NewInt(value), gas, []*big.Int{big.NewInt(1)}, feeCurrency, nil, nil, data)
+}
+
+func (s *SigningPolicyTestSuite) TestCheck() {
+	other := common.HexToAddress("0x1")
+	otherSelector, _ := ParseSelector("transfer(address,uint256)")
+	call := append(s.selector[:], make([]byte, 32)...)
+
+	testcases := []struct {
+		name  string
+		tx    *CeloTransaction
+		valid bool
+	}{
+		{"allowed call", s.newTx(&s.contract, 0, 1000000, nil, call), true},
+		{"allowed fee currency", s.newTx(&s.contract, 100, 2000000, &s.feeCurrency, call), true},
+		{"contract creation", s.newTx(nil, 0, 1000000, nil, call), false},
+		{"contract not allowlisted", s.newTx(&other, 0, 1000000, nil, call), false},
+		{"missing selector", s.newTx(&s.contract, 0, 1000000, nil, []byte{0x01}), false},
+		{"selector not allowed", s.newTx(&s.contract, 0, 1000000, nil, otherSelector[:]), false},
+		{"value above maximum", s.newTx(&s.contract, 101, 1000000, nil, call), false},
+		{"gas above maximum", s.newTx(&s.contract, 0, 2000001, nil, call), false},
+		{"fee currency not allowlisted", s.newTx(&s.contract, 0, 1000000, &other, call), false},
+	}
+	for _, tc := range testcases {
+		err := s.policy.Check(tc.tx)
+		if tc.valid {
+			s.Nil(err, tc.name)
+			continue
+		}
+		var violation *PolicyViolationError
+		s.True(errors.As(err, &violation), tc.name)
+	}
+}
+
+func (s *SigningPolicyTestSuite) TestParseSelector() {
+	bridgeABI, err := abi.JSON(strings.NewReader(consts.BridgeABI))
+	s.Nil(err)
+	abis := []abi.ABI{bridgeABI}
+
+	selector, err := ParseSelector("0x1ff013f1")
+	s.Nil(err)
+	s.Equal([4]byte{0x1f, 0xf0, 0x13, 0xf1}, selector)
+
+	selector, err = ParseSelector("voteProposal", abis...)
+	s.Nil(err)
+	s.Equal(s.selector, selector)
+
+	selector, err = ParseSelector("voteProposal(uint8, uint64, bytes32, bytes)")
+	s.Nil(err)
+	s.Equal(s.selector, selector)
+
+	for _, malformed := range []string{"0x1ff013", "0x1ff013f1ff", "0xzzzzzzzz", "unknownMethod", ""} {
+		_, err := ParseSelector(malformed, abis...)
+		s.NotNil(err, malformed)
+	}
+}
+
+func (s *SigningPolicyTestSuite) TestPolicySignerFn() {
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	signed := false
+	signerFn := NewPolicySignerFn(s.policy, func(signer CeloSigner, address common.Address, tx *CeloTransaction) (*CeloTransaction, error) {
+		signed = true
+		return SignTx(tx, signer, key)
+	})
+	signer := NewEIP155Signer(big.NewInt(44787))
+
+	_, err := signerFn(signer, from, s.newTx(&s.contract, 101, 1000000, nil, s.selector[:]))
+	var violation *PolicyViolationError
+	s.True(errors.As(err, &violation))
+	s.False(signed)
+
+	tx, err := signerFn(signer, from, s.newTx(&s.contract, 0, 1000000, nil, s.selector[:]))
+	s.Nil(err)
+	s.True(signed)
+	sender, err := Sender(signer, tx)
+	s.Nil(err)
+	s.Equal(from, sender)
+}
@@ -0,0 +1,125 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// RemoteSignTimeout bounds a single signing request to the remote signer.
+	// Clef may wait for a manual approval, so it is deliberately generous.
+	RemoteSignTimeout = 2 * time.Minute
+)
+
+var (
+	// ErrRemoteSignerMismatch is returned if the transaction signed by the remote
+	// signer differs from the one requested or recovers to an unexpected address
+	ErrRemoteSignerMismatch = errors.New("remote signer returned an unexpected signature")
+)
+
+// remoteTxArgs is the clef account_signTransaction argument extended with
+// the Celo-specific fields.
+type remoteTxArgs struct {
+	From                common.Address  `json:"from"`
+	To                  *common.Address `json:"to"`
+	Gas                 hexutil.Uint64  `json:"gas"`
+	GasPrice            *hexutil.Big    `json:"gasPrice"`
+	Value               hexutil.Big     `json:"value"`
+	Nonce               hexutil.Uint64  `json:"nonce"`
+	Data                *hexutil.Bytes  `json:"data"`
+	FeeCurrency         *common.Address `json:"feeCurrency"`
+	GatewayFeeRecipient *common.Address `json:"gatewayFeeRecipient"`
+	GatewayFee          *hexutil.Big    `json:"gatewayFee"`
+	EthCompatible       bool            `json:"ethCompatible"`
+	ChainID             *hexutil.Big    `json:"chainId,omitempty"`
+}
+
+// remoteSignResult is the subset of the clef account_signTransaction result we rely on.
+type remoteSignResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+func newRemoteTxArgs(signer CeloSigner, from common.Address, tx *CeloTransaction) remoteTxArgs {
+	data := hexutil.Bytes(tx.Data())
+	args := remoteTxArgs{
+		From:                from,
+		Gas:                 hexutil.Uint64(tx.Gas()),
+		GasPrice:            (*hexutil.Big)(tx.GasPrice()),
+		Value:               hexutil.Big(*tx.Value()),
+		Nonce:               hexutil.Uint64(tx.Nonce()),
+		To:                  tx.To(),
+		Data:                &data,
+		FeeCurrency:         tx.FeeCurrency(),
+		GatewayFeeRecipient: tx.GatewayFeeRecipient(),
+		GatewayFee:          (*hexutil.Big)(tx.GatewayFee()),
+		EthCompatible:       tx.EthCompatible(),
+	}
+	if s, ok := signer.(interface{ ChainID() *big.Int }); ok {
+		args.ChainID = (*hexutil.Big)(s.ChainID())
+	}
+	return args
+}
+
+// NewRemoteTransactor is a utility method to create a transaction signer that
+// delegates signing to an external signer exposing the clef compatible
+// account_signTransaction JSON-RPC method.
+func NewRemoteTransactor(endpoint string, account common.Address) (*TransactOpts, error) {
+	client, err := rpc.DialContext(context.Background(), endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &TransactOpts{
+		From:            account,
+		Signer:          NewRemoteSignerFn(client, account),
+		TypedDataSigner: NewRemoteTypedDataSignerFn(client, account),
+	}, nil
+}
+
+// NewRemoteSignerFn returns a SignerFn which signs transactions of account
+// through the clef compatible signer behind client.
+//
+// The signed transaction is only accepted if it matches the requested one
+// and its signature recovers to account.
+func NewRemoteSignerFn(client *rpc.Client, account common.Address) SignerFn {
+	return func(signer CeloSigner, address common.Address, tx *CeloTransaction) (*CeloTransaction, error) {
+		if address != account {
+			return nil, errors.New("not authorized to sign this account")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), RemoteSignTimeout)
+		defer cancel()
+		var res remoteSignResult
+		err := client.CallContext(ctx, &res, "account_signTransaction", newRemoteTxArgs(signer, address, tx))
+		if err != nil {
+			return nil, fmt.Errorf("remote signer: %w", err)
+		}
+
+		signed := new(CeloTransaction)
+		if err := rlp.DecodeBytes(res.Raw, signed); err != nil {
+			return nil, fmt.Errorf("remote signer: failed decoding signed transaction: %w", err)
+		}
+		if signer.Hash(signed) != signer.Hash(tx) {
+			return nil, ErrRemoteSignerMismatch
+		}
+		from, err := Sender(signer, signed)
+		if err != nil {
+			return nil, err
+		}
+		if from != account {
+			return nil, ErrRemoteSignerMismatch
+		}
+
+		// keep the behaviour of WithSignature which also sets the signature on the
+		// original transaction
+		tx.data.V, tx.data.R, tx.data.S = signed.RawSignatureValues()
+		return signed, nil
+	}
+}
@@ -0,0 +1,137 @@
+package transaction
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/suite"
+)
+
+// clefStub is a minimal stand-in for the clef account API
+type clefStub struct {
+	key     *ecdsa.PrivateKey
+	chainID *big.Int
+	tamper  bool
+	// rawV makes typed data signatures use V 0 or 1 instead of 27 or 28
+	rawV bool
+}
+
+func (c *clefStub) SignTransaction(args remoteTxArgs) (*remoteSignResult, error) {
+	nonce := uint64(args.Nonce)
+	if c.tamper {
+		nonce++
+	}
+	tx := newTransaction(
+		nonce,
+		args.To,
+		args.Value.ToInt(),
+		uint64(args.Gas),
+		[]*big.Int{args.GasPrice.ToInt()},
+		args.FeeCurrency,
+		args.GatewayFeeRecipient,
+		args.GatewayFee.ToInt(),
+		*args.Data,
+	)
+	signed, err := SignTx(tx, NewEIP155Signer(args.ChainID.ToInt()), c.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := rlp.EncodeToBytes(signed)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSignResult{Raw: raw}, nil
+}
+
+func (c *clefStub) SignTypedData(address common.MixedcaseAddress, data apitypes.TypedData) (hexutil.Bytes, error) {
+	signature, err := NewKeyedTypedDataSignerFn(c.key)(crypto.PubkeyToAddress(c.key.PublicKey), data)
+	if err != nil {
+		return nil, err
+	}
+	if c.rawV {
+		signature[64] -= 27
+	}
+	return signature, nil
+}
+
+type RemoteSignerTestSuite struct {
+	suite.Suite
+	stub    *clefStub
+	server  *httptest.Server
+	account common.Address
+	signer  CeloSigner
+}
+
+func TestRunRemoteSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(RemoteSignerTestSuite))
+}
+
+func (s *RemoteSignerTestSuite) SetupTest() {
+	key, _ := crypto.GenerateKey()
+	s.stub = &clefStub{key: key, chainID: big.NewInt(44787)}
+	s.account = crypto.PubkeyToAddress(key.PublicKey)
+	s.signer = NewEIP155Signer(s.stub.chainID)
+
+	server := rpc.NewServer()
+	err := server.RegisterName("account", s.stub)
+	s.Nil(err)
+	s.server = httptest.NewServer(server)
+}
+
+func (s *RemoteSignerTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *RemoteSignerTestSuite) newTx() *CeloTransaction {
+	to := common.HexToAddress("0xd606A00c1A39dA53EA7Bb3Ab570BBE40b156EB66")
+	feeCurrency := common.HexToAddress("0x874069Fa1Eb16D44d622F2e0Ca25eeA172369bC1")
+	return newTransaction(3, &to, big.NewInt(1), 200000, []*big.Int{big.NewInt(5)}, &feeCurrency, nil, big.NewInt(0), []byte{1, 2, 3})
+}
+
+func (s *RemoteSignerTestSuite) TestSignedTransactionRecoversToAccount() {
+	opts, err := NewRemoteTransactor(s.server.URL, s.account)
+	s.Nil(err)
+
+	tx := s.newTx()
+	signed, err := opts.Signer(s.signer, s.account, tx)
+	s.Nil(err)
+
+	from, err := Sender(s.signer, signed)
+	s.Nil(err)
+	s.Equal(s.account, from)
+	s.Equal(tx.Hash(), signed.Hash())
+	s.Equal(*tx.FeeCurrency(), *signed.FeeCurrency())
+}
+
+func (s *RemoteSignerTestSuite) TestUnauthorizedAccount() {
+	opts, err := NewRemoteTransactor(s.server.URL, s.account)
+	s.Nil(err)
+
+	_, err = opts.Signer(s.signer, common.HexToAddress("0x1"), s.newTx())
+	s.NotNil(err)
+}
+
+func (s *RemoteSignerTestSuite) TestUnexpectedAddress() {
+	opts, err := NewRemoteTransactor(s.server.URL, common.HexToAddress("0x1"))
+	s.Nil(err)
+
+	_, err = opts.Signer(s.signer, common.HexToAddress("0x1"), s.newTx())
+	s.Equal(ErrRemoteSignerMismatch, err)
+}
+
+func (s *RemoteSignerTestSuite) TestTamperedTransaction() {
+	s.stub.tamper = true
+	opts, err := NewRemoteTransactor(s.server.URL, s.account)
+	s.Nil(err)
+
+	_, err = opts.Signer(s.signer, s.account, s.newTx())
+	s.Equal(ErrRemoteSignerMismatch, err)
+}
@@ -0,0 +1,148 @@
+package transaction
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	// panicReasons describes the Solidity panic codes
+	panicReasons = map[uint64]string{
+		0x00: "generic compiler panic",
+		0x01: "assertion failed",
+		0x11: "arithmetic overflow or underflow",
+		0x12: "division or modulo by zero",
+		0x21: "invalid enum value",
+		0x22: "invalid storage byte array encoding",
+		0x31: "pop on empty array",
+		0x32: "array index out of bounds",
+		0x41: "out of memory",
+		0x51: "call to zero-initialized internal function",
+	}
+)
+
+// RevertError is returned if a contract call reverts. Reason is the decoded revert
+// reason, which is empty if the call reverted without one.
+type RevertError struct {
+	Reason string
+	Err    error
+}
+
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return "execution reverted"
+	}
+	return fmt.Sprintf("execution reverted: %s", e.Reason)
+}
+
+func (e *RevertError) Unwrap() error {
+	return e.Err
+}
+
+// BridgeABIs returns the ABIs of the bridge, its handlers and the ERC20 and ERC721
+// tokens, which are used to decode bridge calls and their errors
+func BridgeABIs() ([]abi.ABI, error) {
+	var abis []abi.ABI
+	for _, a := range []string{
+		consts.BridgeABI,
+		consts.ERC20HandlerABI,
+		consts.ERC721HandlerABI,
+		consts.GenericHandlerABI,
+		consts.ERC20PresetMinterPauserABI,
+		consts.ERC721PresetMinterPauserABI,
+	} {
+		parsed, err := abi.JSON(strings.NewReader(a))
+		if err != nil {
+			return nil, err
+		}
+		abis = append(abis, parsed)
+	}
+	return abis, nil
+}
+
+// DecodeRevert decodes revert data as an Error(string) reason, a Panic(uint256)
+// code or a custom error of one of abis. Unknown data is returned hex encoded.
+func DecodeRevert(data []byte, abis ...abi.ABI) string {
+	if len(data) == 0 {
+		return ""
+	}
+	if len(data) >= 4 {
+		switch {
+		case bytes.Equal(data[:4], errorSelector):
+			if reason, err := abi.UnpackRevert(data); err == nil {
+				return reason
+			}
+		case bytes.Equal(data[:4], panicSelector) && len(data) == 36:
+			code := new(big.Int).SetBytes(data[4:])
+			if reason, ok := panicReasons[code.Uint64()]; ok && code.IsUint64() {
+				return fmt.Sprintf("panic: %s (0x%02x)", reason, code.Uint64())
+			}
+			return fmt.Sprintf("panic: unknown code 0x%s", code.Text(16))
+		}
+		for _, a := range abis {
+			for _, e := range a.Errors {
+				if !bytes.Equal(data[:4], e.ID[:4]) {
+					continue
+				}
+				values, err := e.Inputs.UnpackValues(data[4:])
+				if err != nil {
+					continue
+				}
+				args := make([]string, len(values))
+				for i, v := range values {
+					args[i] = CallArg{Value: v}.String()
+				}
+				return fmt.Sprintf("%s(%s)", e.Name, strings.Join(args, ", "))
+			}
+		}
+	}
+	return fmt.Sprintf("unknown revert data %s", hexutil.Encode(data))
+}
+
+// RevertReason returns the decoded reason of the error of a reverted eth_call.
+// Nodes return the revert data as error data, older nodes only include the
+// reason in the error message. It returns false if err isn't a revert.
+func RevertReason(err error, abis ...abi.ABI) (string, bool) {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			if b, decErr := hexutil.Decode(data); decErr == nil {
+				return DecodeRevert(b, abis...), true
+			}
+		}
+	}
+	msg := err.Error()
+	if i := strings.Index(msg, "execution reverted"); i >= 0 {
+		return strings.TrimPrefix(strings.TrimPrefix(msg[i:], "execution reverted"), ": "), true
+	}
+	return "", false
+}
+
+// WrapRevert returns err as a RevertError with the decoded revert reason if it is
+// the error of a reverted call, otherwise err is returned unchanged
+func WrapRevert(err error, abis ...abi.ABI) error {
+	if err == nil {
+		return nil
+	}
+	var revertErr *RevertError
+	if errors.As(err, &revertErr) {
+		return err
+	}
+	reason, ok := RevertReason(err, abis...)
+	if !ok {
+		return err
+	}
+	return &RevertError{Reason: reason, Err: err}
+}
@@ -0,0 +1,99 @@
+package transaction
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/suite"
+)
+
+const customErrorABI = `[{"type":"error","name":"InsufficientBalance","inputs":[{"name":"account","type":"address"},{"name":"required","type":"uint256"}]}]`
+
+type dataError struct {
+	msg  string
+	data interface{}
+}
+
+func (e *dataError) Error() string          { return e.msg }
+func (e *dataError) ErrorData() interface{} { return e.data }
+
+type RevertTestSuite struct {
+	suite.Suite
+	customABI abi.ABI
+}
+
+func TestRunRevertTestSuite(t *testing.T) {
+	suite.Run(t, new(RevertTestSuite))
+}
+
+func (s *RevertTestSuite) SetupSuite() {
+	s.customABI, _ = abi.JSON(strings.NewReader(customErrorABI))
+}
+
+func (s *RevertTestSuite) errorData(reason string) []byte {
+	typ, _ := abi.NewType("string", "", nil)
+	packed, err := abi.Arguments{{Type: typ}}.Pack(reason)
+	s.Nil(err)
+	return append(append([]byte{}, errorSelector...), packed...)
+}
+
+func (s *RevertTestSuite) panicData(code int64) []byte {
+	return append(append([]byte{}, panicSelector...), common.LeftPadBytes(big.NewInt(code).Bytes(), 32)...)
+}
+
+func (s *RevertTestSuite) TestDecodesErrorString() {
+	s.Equal("relayer already voted", DecodeRevert(s.errorData("relayer already voted")))
+}
+
+func (s *RevertTestSuite) TestDecodesPanic() {
+	s.Equal("panic: arithmetic overflow or underflow (0x11)", DecodeRevert(s.panicData(0x11)))
+	s.Equal("panic: unknown code 0x99", DecodeRevert(s.panicData(0x99)))
+}
+
+func (s *RevertTestSuite) TestDecodesCustomError() {
+	account := common.HexToAddress("0x829bd824b016326a401d083b33d092293333a830")
+	e := s.customABI.Errors["InsufficientBalance"]
+	packed, err := e.Inputs.Pack(account, big.NewInt(100))
+	s.Nil(err)
+	data := append(e.ID[:4], packed...)
+
+	s.Equal("InsufficientBalance("+account.Hex()+", 100)", DecodeRevert(data, s.customABI))
+	s.Equal("unknown revert data "+hexutil.Encode(data), DecodeRevert(data))
+}
+
+func (s *RevertTestSuite) TestRevertReasonFromErrorData() {
+	err := &dataError{msg: "execution reverted", data: hexutil.Encode(s.errorData("bridge paused"))}
+
+	reason, ok := RevertReason(err)
+	s.True(ok)
+	s.Equal("bridge paused", reason)
+}
+
+func (s *RevertTestSuite) TestRevertReasonFromMessage() {
+	reason, ok := RevertReason(errors.New("execution reverted: bridge paused"))
+	s.True(ok)
+	s.Equal("bridge paused", reason)
+
+	_, ok = RevertReason(errors.New("connection refused"))
+	s.False(ok)
+}
+
+func (s *RevertTestSuite) TestWrapRevert() {
+	s.Nil(WrapRevert(nil))
+
+	other := errors.New("connection refused")
+	s.Equal(other, WrapRevert(other))
+
+	err := &dataError{msg: "execution reverted", data: hexutil.Encode(s.panicData(0x01))}
+	wrapped := WrapRevert(err)
+	var revertErr *RevertError
+	s.True(errors.As(wrapped, &revertErr))
+	s.Equal("panic: assertion failed (0x01)", revertErr.Reason)
+	s.Equal("execution reverted: panic: assertion failed (0x01)", wrapped.Error())
+	s.True(errors.Is(wrapped, err))
+}
@@ -102,6 +102,11 @@ func NewEIP155Signer(chainId *big.Int) EIP155Signer {
 	}
 }
 
+// ChainID returns the chain id the signer protects transactions for.
+func (s EIP155Signer) ChainID() *big.Int {
+	return s.chainId
+}
+
 func (s EIP155Signer) Equal(s2 CeloSigner) bool {
 	eip155, ok := s2.(EIP155Signer)
 	return ok && eip155.chainId.Cmp(s.chainId) == 0
@@ -111,6 +116,9 @@ var big8 = big.NewInt(8)
 
 func (s EIP155Signer) Sender(tx *CeloTransaction) (common.Address, error) {
 	if !tx.Protected() {
+		if StrictReplayProtection() {
+			return common.Address{}, &UnprotectedTxError{Op: "accept"}
+		}
 		return HomesteadSigner{}.Sender(tx)
 	}
 	if tx.ChainId().Cmp(s.chainId) != 0 {
@@ -125,10 +133,10 @@ func (s EIP155Signer) Sender(tx *CeloTransaction) (common.Address, error) {
 // SignatureValues returns signature values. This signature
 // needs to be in the [R || S || V] format where V is 0 or 1.
 func (s EIP155Signer) SignatureValues(tx *CeloTransaction, sig []byte) (R, S, V *big.Int, err error) {
-	R, S, V, err = HomesteadSigner{}.SignatureValues(tx, sig)
-	if err != nil {
-		return nil, nil, nil, err
+	if s.chainId.Sign() == 0 && StrictReplayProtection() {
+		return nil, nil, nil, &UnprotectedTxError{Op: "sign"}
 	}
+	R, S, V = decodeSignature(sig)
 	if s.chainId.Sign() != 0 {
 		V = big.NewInt(int64(sig[64] + 35))
 		V.Add(V, s.chainIdMul)
@@ -181,16 +189,16 @@ func (hs HomesteadSigner) SignatureValues(tx *CeloTransaction, sig []byte) (r, s
 }
 
 func (hs HomesteadSigner) Sender(tx *CeloTransaction) (common.Address, error) {
+	if StrictReplayProtection() {
+		return common.Address{}, &UnprotectedTxError{Op: "accept"}
+	}
 	addr, _, err := recoverPlain(hs.Hash(tx), tx.data.R, tx.data.S, tx.data.V, true)
 	return addr, err
 }
 
 func (hs HomesteadSigner) SenderData(data common.Hash, sig []byte) (common.Address, []byte, error) {
-	r, s, v, err := hs.SignatureValues(nil, sig)
+	r, s, v := decodeSignature(sig)
 	v = new(big.Int).Sub(v, big.NewInt(27))
-	if err != nil {
-		return common.Address{}, nil, err
-	}
 	return recoverPlain(data, r, s, v, true)
 }
 
@@ -204,13 +212,21 @@ func (s FrontierSigner) Equal(s2 CeloSigner) bool {
 // SignatureValues returns signature values. This signature
 // needs to be in the [R || S || V] format where V is 0 or 1.
 func (fs FrontierSigner) SignatureValues(tx *CeloTransaction, sig []byte) (r, s, v *big.Int, err error) {
+	if StrictReplayProtection() {
+		return nil, nil, nil, &UnprotectedTxError{Op: "sign"}
+	}
+	r, s, v = decodeSignature(sig)
+	return r, s, v, nil
+}
+
+func decodeSignature(sig []byte) (r, s, v *big.Int) {
 	if len(sig) != crypto.SignatureLength {
 		panic(fmt.Sprintf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength))
 	}
 	r = new(big.Int).SetBytes(sig[:32])
 	s = new(big.Int).SetBytes(sig[32:64])
 	v = new(big.Int).SetBytes([]byte{sig[64] + 27})
-	return r, s, v, nil
+	return r, s, v
 }
 
 // Hash returns the hash to be signed by the sender.
@@ -241,6 +257,9 @@ func (fs FrontierSigner) Hash(tx *CeloTransaction) common.Hash {
 }
 
 func (fs FrontierSigner) Sender(tx *CeloTransaction) (common.Address, error) {
+	if StrictReplayProtection() {
+		return common.Address{}, &UnprotectedTxError{Op: "accept"}
+	}
 	addr, _, err := recoverPlain(fs.Hash(tx), tx.data.R, tx.data.S, tx.data.V, false)
 	return addr, err
 }
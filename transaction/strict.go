@@ -0,0 +1,47 @@
+package transaction
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var strictReplayProtection int32
+
+// UnprotectedTxError is returned in strict replay protection mode when a
+// transaction without EIP-155 replay protection would be signed or accepted.
+type UnprotectedTxError struct {
+	Op string
+}
+
+func (e *UnprotectedTxError) Error() string {
+	return fmt.Sprintf("refusing to %s transaction without EIP-155 replay protection", e.Op)
+}
+
+// SetStrictReplayProtection enables or disables strict replay protection mode.
+// In strict mode signers refuse to sign transactions without a chain id and
+// refuse to derive the sender of unprotected transactions.
+func SetStrictReplayProtection(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&strictReplayProtection, v)
+}
+
+// StrictReplayProtection returns true if strict replay protection mode is enabled.
+func StrictReplayProtection() bool {
+	return atomic.LoadInt32(&strictReplayProtection) == 1
+}
+
+// InspectSender returns the sender of tx regardless of strict replay protection
+// mode. It is meant for inspecting transactions only: strict mode guards
+// signing and accepting transactions, not looking at who signed them.
+func InspectSender(tx *CeloTransaction) (common.Address, error) {
+	if tx.Protected() {
+		return Sender(NewEIP155Signer(tx.ChainId()), tx)
+	}
+	addr, _, err := recoverPlain(HomesteadSigner{}.Hash(tx), tx.data.R, tx.data.S, tx.data.V, true)
+	return addr, err
+}
@@ -0,0 +1,80 @@
+package transaction
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+)
+
+type StrictReplayProtectionTestSuite struct {
+	suite.Suite
+}
+
+func TestRunStrictReplayProtectionTestSuite(t *testing.T) {
+	suite.Run(t, new(StrictReplayProtectionTestSuite))
+}
+
+func (s *StrictReplayProtectionTestSuite) SetupTest() {
+	SetStrictReplayProtection(true)
+}
+
+func (s *StrictReplayProtectionTestSuite) TearDownTest() {
+	SetStrictReplayProtection(false)
+}
+
+func (s *StrictReplayProtectionTestSuite) newTx() *CeloTransaction {
+	to := common.HexToAddress("0x1")
+	return newTransaction(0, &to, big.NewInt(0), 21000, []*big.Int{big.NewInt(1)}, nil, nil, nil, nil)
+}
+
+func (s *StrictReplayProtectionTestSuite) TestSignsProtectedTransaction() {
+	key, _ := crypto.GenerateKey()
+	signer := NewEIP155Signer(big.NewInt(44787))
+
+	tx, err := SignTx(s.newTx(), signer, key)
+	s.Nil(err)
+	s.True(tx.Protected())
+
+	from, err := Sender(signer, tx)
+	s.Nil(err)
+	s.Equal(crypto.PubkeyToAddress(key.PublicKey), from)
+}
+
+func (s *StrictReplayProtectionTestSuite) TestRefusesToSignWithoutChainId() {
+	key, _ := crypto.GenerateKey()
+
+	_, err := SignTx(s.newTx(), NewEIP155Signer(nil), key)
+	var unprotectedErr *UnprotectedTxError
+	s.True(errors.As(err, &unprotectedErr))
+
+	_, err = SignTx(s.newTx(), HomesteadSigner{}, key)
+	s.True(errors.As(err, &unprotectedErr))
+}
+
+func (s *StrictReplayProtectionTestSuite) TestRefusesUnprotectedSender() {
+	key, _ := crypto.GenerateKey()
+	SetStrictReplayProtection(false)
+	tx, err := SignTx(s.newTx(), HomesteadSigner{}, key)
+	s.Nil(err)
+	SetStrictReplayProtection(true)
+
+	_, err = Sender(NewEIP155Signer(big.NewInt(44787)), tx)
+	var unprotectedErr *UnprotectedTxError
+	s.True(errors.As(err, &unprotectedErr))
+}
+
+func (s *StrictReplayProtectionTestSuite) TestInspectsUnprotectedSender() {
+	key, _ := crypto.GenerateKey()
+	SetStrictReplayProtection(false)
+	tx, err := SignTx(s.newTx(), HomesteadSigner{}, key)
+	s.Nil(err)
+	SetStrictReplayProtection(true)
+
+	from, err := InspectSender(tx)
+	s.Nil(err)
+	s.Equal(crypto.PubkeyToAddress(key.PublicKey), from)
+}
@@ -0,0 +1,187 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// TransitSignTimeout bounds a single signing request to the transit service.
+	TransitSignTimeout = 30 * time.Second
+)
+
+var (
+	// ErrInvalidTransitSignature is returned if the transit service signature can't be
+	// decoded or doesn't recover to the expected address
+	ErrInvalidTransitSignature = errors.New("transit signer returned an invalid signature")
+
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Div(secp256k1N, big.NewInt(2))
+)
+
+type transitSignRequest struct {
+	Input               string `json:"input"`
+	Prehashed           bool   `json:"prehashed"`
+	MarshalingAlgorithm string `json:"marshaling_algorithm"`
+}
+
+type transitSignResponse struct {
+	Data struct {
+		Signature string `json:"signature"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+// TransitSigner signs raw 32-byte digests with a named secp256k1 key held by a
+// transit-style HTTP signing service.
+type TransitSigner struct {
+	endpoint string
+	keyName  string
+	token    string
+	account  common.Address
+	client   *http.Client
+}
+
+// NewTransitSigner creates a TransitSigner for the key named keyName, which is
+// expected to belong to account.
+func NewTransitSigner(endpoint, keyName, token string, account common.Address) *TransitSigner {
+	return &TransitSigner{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		keyName:  keyName,
+		token:    token,
+		account:  account,
+		client:   &http.Client{Timeout: TransitSignTimeout},
+	}
+}
+
+// NewTransitTransactor is a utility method to create a transaction signer that
+// signs transaction hashes with a key held by a transit-style signing service.
+func NewTransitTransactor(endpoint, keyName, token string, account common.Address) *TransactOpts {
+	s := NewTransitSigner(endpoint, keyName, token, account)
+	return &TransactOpts{
+		From:            account,
+		TypedDataSigner: NewTransitTypedDataSignerFn(s),
+		Signer: func(signer CeloSigner, address common.Address, tx *CeloTransaction) (*CeloTransaction, error) {
+			if address != account {
+				return nil, errors.New("not authorized to sign this account")
+			}
+			signature, err := s.SignHash(signer.Hash(tx).Bytes())
+			if err != nil {
+				return nil, err
+			}
+			return tx.WithSignature(signer, signature)
+		},
+	}
+}
+
+// SignHash signs the given digest and returns the signature in the
+// [R || S || V] format where V is 0 or 1.
+func (s *TransitSigner) SignHash(hash []byte) ([]byte, error) {
+	if len(hash) != common.HashLength {
+		return nil, fmt.Errorf("hash is required to be exactly %d bytes (%d)", common.HashLength, len(hash))
+	}
+	body, err := json.Marshal(transitSignRequest{
+		Input:               base64.StdEncoding.EncodeToString(hash),
+		Prehashed:           true,
+		MarshalingAlgorithm: "asn1",
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/v1/transit/sign/%s", s.endpoint, s.keyName), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("X-Vault-Token", s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("transit signer: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var res transitSignResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("transit signer: failed decoding response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("transit signer: status %d: %s", resp.StatusCode, strings.Join(res.Errors, "; "))
+	}
+
+	raw, err := decodeTransitSignature(res.Data.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return toRecoverableSignature(hash, raw, s.account)
+}
+
+// decodeTransitSignature strips the optional "vault:v<version>:" prefix and
+// base64 decodes the signature.
+func decodeTransitSignature(signature string) ([]byte, error) {
+	if parts := strings.Split(signature, ":"); len(parts) == 3 {
+		signature = parts[2]
+	}
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, ErrInvalidTransitSignature
+	}
+	return raw, nil
+}
+
+// toRecoverableSignature converts a DER or compact ([R || S] or [R || S || V])
+// signature into the [R || S || V] format where V is 0 or 1, by finding the
+// recovery id that recovers to account.
+func toRecoverableSignature(hash []byte, raw []byte, account common.Address) ([]byte, error) {
+	var r, s *big.Int
+	switch len(raw) {
+	case 64, crypto.SignatureLength:
+		r = new(big.Int).SetBytes(raw[:32])
+		s = new(big.Int).SetBytes(raw[32:64])
+	default:
+		var der struct {
+			R, S *big.Int
+		}
+		rest, err := asn1.Unmarshal(raw, &der)
+		if err != nil || len(rest) != 0 {
+			return nil, ErrInvalidTransitSignature
+		}
+		r, s = der.R, der.S
+	}
+	if r.Sign() <= 0 || s.Sign() <= 0 || r.Cmp(secp256k1N) >= 0 || s.Cmp(secp256k1N) >= 0 {
+		return nil, ErrInvalidTransitSignature
+	}
+
+	// secp256k1 signatures are malleable, ethereum only accepts the lower S value
+	if s.Cmp(secp256k1HalfN) > 0 {
+		s = new(big.Int).Sub(secp256k1N, s)
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	for v := byte(0); v < 2; v++ {
+		sig[64] = v
+		pub, err := crypto.SigToPub(hash, sig)
+		if err != nil {
+			continue
+		}
+		if crypto.PubkeyToAddress(*pub) == account {
+			return sig, nil
+		}
+	}
+	return nil, ErrInvalidTransitSignature
+}
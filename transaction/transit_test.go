@@ -0,0 +1,84 @@
+package transaction
+
+import (
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+)
+
+type TransitSignerTestSuite struct {
+	suite.Suite
+	server  *httptest.Server
+	account common.Address
+}
+
+func TestRunTransitSignerTestSuite(t *testing.T) {
+	suite.Run(t, new(TransitSignerTestSuite))
+}
+
+func (s *TransitSignerTestSuite) SetupTest() {
+	key, _ := crypto.GenerateKey()
+	s.account = crypto.PubkeyToAddress(key.PublicKey)
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/transit/sign/relayer" || r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		var req transitSignRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		hash, _ := base64.StdEncoding.DecodeString(req.Input)
+		sig, _ := crypto.Sign(hash, key)
+
+		// respond with the high S DER encoding to exercise normalisation
+		der, _ := asn1.Marshal(struct{ R, S *big.Int }{
+			new(big.Int).SetBytes(sig[:32]),
+			new(big.Int).Sub(secp256k1N, new(big.Int).SetBytes(sig[32:64])),
+		})
+		var res transitSignResponse
+		res.Data.Signature = "vault:v1:" + base64.StdEncoding.EncodeToString(der)
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+}
+
+func (s *TransitSignerTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *TransitSignerTestSuite) TestSignedTransactionRecoversToAccount() {
+	opts := NewTransitTransactor(s.server.URL, "relayer", "token", s.account)
+	signer := NewEIP155Signer(big.NewInt(42220))
+	to := common.HexToAddress("0xd606A00c1A39dA53EA7Bb3Ab570BBE40b156EB66")
+	tx := newTransaction(0, &to, big.NewInt(0), 100000, []*big.Int{big.NewInt(1)}, nil, nil, nil, nil)
+
+	signed, err := opts.Signer(signer, s.account, tx)
+	s.Nil(err)
+
+	from, err := Sender(signer, signed)
+	s.Nil(err)
+	s.Equal(s.account, from)
+}
+
+func (s *TransitSignerTestSuite) TestUnexpectedKey() {
+	opts := NewTransitTransactor(s.server.URL, "relayer", "token", common.HexToAddress("0x1"))
+	to := common.HexToAddress("0xd606A00c1A39dA53EA7Bb3Ab570BBE40b156EB66")
+	tx := newTransaction(0, &to, big.NewInt(0), 100000, []*big.Int{big.NewInt(1)}, nil, nil, nil, nil)
+
+	_, err := opts.Signer(NewEIP155Signer(big.NewInt(42220)), common.HexToAddress("0x1"), tx)
+	s.Equal(ErrInvalidTransitSignature, err)
+}
+
+func (s *TransitSignerTestSuite) TestRejectedRequest() {
+	signer := NewTransitSigner(s.server.URL, "relayer", "wrong", s.account)
+
+	_, err := signer.SignHash(common.Hash{1}.Bytes())
+	s.NotNil(err)
+}
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"sync/atomic"
 
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -31,6 +32,20 @@ func NewCeloTransaction(nonce uint64, to *common.Address, amount *big.Int, gasLi
 	return newTransaction(nonce, to, amount, gasLimit, gasPrice, nil, nil, nil, data), nil
 }
 
+// FeeOptions holds the Celo specific fee fields of a transaction
+type FeeOptions struct {
+	FeeCurrency         *common.Address // nil means gas is paid in CELO
+	GatewayFeeRecipient *common.Address // nil means no gateway fee is paid
+	GatewayFee          *big.Int
+}
+
+// TxFabric returns a calls.TxFabric that creates Celo transactions with the fee options
+func (o FeeOptions) TxFabric() calls.TxFabric {
+	return func(nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrice []*big.Int, data []byte) (evmclient.CommonTransaction, error) {
+		return newTransaction(nonce, to, amount, gasLimit, gasPrice, o.FeeCurrency, o.GatewayFeeRecipient, o.GatewayFee, data), nil
+	}
+}
+
 type CeloTransaction struct {
 	data txdata
 	// caches
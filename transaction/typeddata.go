@@ -0,0 +1,147 @@
+package transaction
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+var (
+	// ErrTypedDataNotSupported is returned if the signing backend can't sign EIP-712 typed data
+	ErrTypedDataNotSupported = errors.New("signer does not support typed data signing")
+)
+
+// TypedDataSignerFn signs EIP-712 typed data and returns the signature in the
+// [R || S || V] format where V is 27 or 28.
+type TypedDataSignerFn func(common.Address, apitypes.TypedData) ([]byte, error)
+
+// DomainSeparator returns the EIP-712 hash of the typed data domain
+func DomainSeparator(data apitypes.TypedData) (common.Hash, error) {
+	hash, err := data.HashStruct("EIP712Domain", data.Domain.Map())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// TypedDataHash returns the EIP-712 digest of data that is signed:
+// keccak256("\x19\x01" || domainSeparator || hashStruct(message)).
+func TypedDataHash(data apitypes.TypedData) (common.Hash, error) {
+	domainSeparator, err := DomainSeparator(data)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed hashing domain: %w", err)
+	}
+	structHash, err := data.HashStruct(data.PrimaryType, data.Message)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed hashing %s: %w", data.PrimaryType, err)
+	}
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domainSeparator.Bytes(), structHash), nil
+}
+
+// RecoverTypedData returns the address that produced signature over data
+func RecoverTypedData(data apitypes.TypedData, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("signature is required to be exactly %d bytes (%d)", crypto.SignatureLength, len(signature))
+	}
+	hash, err := TypedDataHash(data)
+	if err != nil {
+		return common.Address{}, err
+	}
+	sig := common.CopyBytes(signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// SignTypedData signs data with the typed data signer of the transactor
+func (opts *TransactOpts) SignTypedData(data apitypes.TypedData) ([]byte, error) {
+	if opts.TypedDataSigner == nil {
+		return nil, ErrTypedDataNotSupported
+	}
+	return opts.TypedDataSigner(opts.From, data)
+}
+
+// newHashTypedDataSignerFn returns a TypedDataSignerFn that signs the typed
+// data digest of account with signHash
+func newHashTypedDataSignerFn(account common.Address, signHash func([]byte) ([]byte, error)) TypedDataSignerFn {
+	return func(address common.Address, data apitypes.TypedData) ([]byte, error) {
+		if address != account {
+			return nil, errors.New("not authorized to sign this account")
+		}
+		hash, err := TypedDataHash(data)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := signHash(hash.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		signature[64] += 27
+		return signature, nil
+	}
+}
+
+// NewKeyedTypedDataSignerFn returns a TypedDataSignerFn signing with a single private key
+func NewKeyedTypedDataSignerFn(key *ecdsa.PrivateKey) TypedDataSignerFn {
+	return newHashTypedDataSignerFn(crypto.PubkeyToAddress(key.PublicKey), func(hash []byte) ([]byte, error) {
+		return crypto.Sign(hash, key)
+	})
+}
+
+// NewKeyStoreTypedDataSignerFn returns a TypedDataSignerFn signing with an unlocked keystore account
+func NewKeyStoreTypedDataSignerFn(ks *keystore.KeyStore, account accounts.Account) TypedDataSignerFn {
+	return newHashTypedDataSignerFn(account.Address, func(hash []byte) ([]byte, error) {
+		return ks.SignHash(account, hash)
+	})
+}
+
+// NewTransitTypedDataSignerFn returns a TypedDataSignerFn signing with a transit service key
+func NewTransitTypedDataSignerFn(s *TransitSigner) TypedDataSignerFn {
+	return newHashTypedDataSignerFn(s.account, s.SignHash)
+}
+
+// NewRemoteTypedDataSignerFn returns a TypedDataSignerFn which signs typed data
+// of account through the clef compatible account_signTypedData method.
+//
+// The signature is only accepted if it recovers to account. Signers returning
+// V as 0 or 1 are normalised to 27 or 28.
+func NewRemoteTypedDataSignerFn(client *rpc.Client, account common.Address) TypedDataSignerFn {
+	return func(address common.Address, data apitypes.TypedData) ([]byte, error) {
+		if address != account {
+			return nil, errors.New("not authorized to sign this account")
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), RemoteSignTimeout)
+		defer cancel()
+		var signature hexutil.Bytes
+		err := client.CallContext(ctx, &signature, "account_signTypedData", account.Hex(), data)
+		if err != nil {
+			return nil, fmt.Errorf("remote signer: %w", err)
+		}
+		from, err := RecoverTypedData(data, signature)
+		if err != nil {
+			return nil, err
+		}
+		if from != account {
+			return nil, ErrRemoteSignerMismatch
+		}
+		if signature[64] < 27 {
+			signature[64] += 27
+		}
+		return signature, nil
+	}
+}
@@ -0,0 +1,132 @@
+package transaction
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/suite"
+)
+
+// mailTypedData is the example from the EIP-712 specification
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": "1",
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+type TypedDataTestSuite struct {
+	suite.Suite
+	data apitypes.TypedData
+}
+
+func TestRunTypedDataTestSuite(t *testing.T) {
+	suite.Run(t, new(TypedDataTestSuite))
+}
+
+func (s *TypedDataTestSuite) SetupTest() {
+	s.data = apitypes.TypedData{}
+	err := json.Unmarshal([]byte(mailTypedData), &s.data)
+	s.Nil(err)
+}
+
+func (s *TypedDataTestSuite) TestTypedDataHash() {
+	domainSeparator, err := DomainSeparator(s.data)
+	s.Nil(err)
+	s.Equal(common.HexToHash("0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"), domainSeparator)
+
+	hash, err := TypedDataHash(s.data)
+	s.Nil(err)
+	s.Equal(common.HexToHash("0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"), hash)
+}
+
+func (s *TypedDataTestSuite) TestKeyedSignature() {
+	key := crypto.ToECDSAUnsafe(crypto.Keccak256([]byte("cow")))
+	opts := NewKeyedTransactor(key)
+
+	signature, err := opts.SignTypedData(s.data)
+	s.Nil(err)
+	s.Equal("0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b915621c", hexutil.Encode(signature))
+
+	from, err := RecoverTypedData(s.data, signature)
+	s.Nil(err)
+	s.Equal(common.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"), from)
+}
+
+func (s *TypedDataTestSuite) TestRemoteSignature() {
+	key, _ := crypto.GenerateKey()
+	account := crypto.PubkeyToAddress(key.PublicKey)
+	server := rpc.NewServer()
+	err := server.RegisterName("account", &clefStub{key: key})
+	s.Nil(err)
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	opts, err := NewRemoteTransactor(httpServer.URL, account)
+	s.Nil(err)
+	signature, err := opts.SignTypedData(s.data)
+	s.Nil(err)
+	from, err := RecoverTypedData(s.data, signature)
+	s.Nil(err)
+	s.Equal(account, from)
+
+	opts, err = NewRemoteTransactor(httpServer.URL, common.HexToAddress("0x1"))
+	s.Nil(err)
+	_, err = opts.SignTypedData(s.data)
+	s.Equal(ErrRemoteSignerMismatch, err)
+}
+
+func (s *TypedDataTestSuite) TestRemoteSignatureNormalisesV() {
+	key, _ := crypto.GenerateKey()
+	account := crypto.PubkeyToAddress(key.PublicKey)
+	server := rpc.NewServer()
+	err := server.RegisterName("account", &clefStub{key: key, rawV: true})
+	s.Nil(err)
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	opts, err := NewRemoteTransactor(httpServer.URL, account)
+	s.Nil(err)
+	signature, err := opts.SignTypedData(s.data)
+	s.Nil(err)
+	s.Contains([]byte{27, 28}, signature[64])
+	from, err := RecoverTypedData(s.data, signature)
+	s.Nil(err)
+	s.Equal(account, from)
+}
+
+func (s *TypedDataTestSuite) TestNotSupported() {
+	_, err := (&TransactOpts{}).SignTypedData(s.data)
+	s.Equal(ErrTypedDataNotSupported, err)
+}